@@ -1,11 +1,15 @@
 package client_adapters
 
 import (
+	"context"
 	"errors"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 )
 
 const (
@@ -21,11 +25,12 @@ var (
 )
 
 type CircuitBreaker struct {
-	mu                   sync.Mutex // Guards the circuit breaker state because cicuit breaker can be used by multiple go routines
-	state                string     // Current state of the circuit breaker
-	failureCount         int        // Number of consecutive failures
-	lastFailureTime      time.Time  // Time of the last failure
-	halfOpenSuccessCount int        // Successful requests in half-open state
+	mu                   sync.Mutex   // Guards the circuit breaker state because cicuit breaker can be used by multiple go routines
+	state                string       // Current state of the circuit breaker
+	observedState        atomic.Value // Copy of the state read by the metrics without waiting for the running request
+	failureCount         int          // Number of consecutive failures
+	lastFailureTime      time.Time    // Time of the last failure
+	halfOpenSuccessCount int          // Successful requests in half-open state
 
 	failureThreshold    int           // number of failures to trigger open state
 	recoveryTime        time.Duration // Wait time before changin from open state to half-open
@@ -35,7 +40,7 @@ type CircuitBreaker struct {
 }
 
 func NewCircuitBreaker(failureThreshold int, recoveryTime time.Duration, halfOpenMaxRequests int, timeout time.Duration, logger *zerolog.Logger) *CircuitBreaker {
-	return &CircuitBreaker{
+	cb := &CircuitBreaker{
 		state:                circuitClosedState,
 		failureCount:         0,
 		halfOpenSuccessCount: 0,
@@ -45,6 +50,11 @@ func NewCircuitBreaker(failureThreshold int, recoveryTime time.Duration, halfOpe
 		timeout:              timeout,
 		logger:               logger,
 	}
+	cb.observedState.Store(circuitClosedState)
+	if _, err := meter.RegisterCallback(cb.observeState, circuitBreakerStateGauge); err != nil {
+		logger.Warn().Err(err).Msg("couldn't register the circuit breaker state metric")
+	}
+	return cb
 }
 
 func (cb *CircuitBreaker) Call(fn func() (any, error)) (any, error) {
@@ -70,8 +80,7 @@ func (cb *CircuitBreaker) HandleClosedState(fn func() (any, error)) (any, error)
 		cb.failureCount++
 		cb.lastFailureTime = time.Now()
 		if cb.failureCount >= cb.failureThreshold {
-			cb.state = circuitOpenState
-
+			cb.setState(circuitOpenState)
 		}
 		return nil, err
 	}
@@ -82,12 +91,13 @@ func (cb *CircuitBreaker) HandleClosedState(fn func() (any, error)) (any, error)
 func (cb *CircuitBreaker) HandleOpenState() (any, error) {
 	if time.Since(cb.lastFailureTime) >= cb.recoveryTime {
 		cb.logger.Info().Msg("circuit breaker state is changing to half-open")
-		cb.state = circuitHalfOpenState
+		cb.setState(circuitHalfOpenState)
 		cb.failureCount = 0
 		cb.halfOpenSuccessCount = 0
 		return nil, nil
 	}
 	cb.logger.Warn().Msg("circuit breaker state is open. rejecting the request")
+	circuitBreakerRejectedCounter.Add(context.Background(), 1)
 	return nil, ErrCircuitOpen
 }
 
@@ -98,7 +108,7 @@ func (cb *CircuitBreaker) HandleHalfOpenState(fn func() (any, error)) (any, erro
 		cb.logger.Warn().Msg("circuit breaker state is changing from half-open to open")
 		cb.failureCount++
 		cb.lastFailureTime = time.Now()
-		cb.state = circuitOpenState
+		cb.setState(circuitOpenState)
 
 		return nil, err
 	}
@@ -137,6 +147,32 @@ func runWithTimeout(fn func() (any, error), timeout time.Duration) (any, error)
 
 func (cb *CircuitBreaker) resetCircuit() {
 	cb.failureCount = 0
-	cb.state = circuitClosedState
+	cb.setState(circuitClosedState)
 	cb.logger.Debug().Msg("circuit breaker state reseted to close")
 }
+
+// setState moves the circuit to the given state and counts the transition
+func (cb *CircuitBreaker) setState(state string) {
+	if cb.state == state {
+		return
+	}
+	circuitBreakerTransitionsCounter.Add(context.Background(), 1, metric.WithAttributes(
+		attribute.String("from", cb.state),
+		attribute.String("to", state),
+	))
+	cb.state = state
+	cb.observedState.Store(state)
+}
+
+// observeState reports 1 for the current state of the circuit and 0 for the other states
+func (cb *CircuitBreaker) observeState(_ context.Context, o metric.Observer) error {
+	current := cb.observedState.Load().(string)
+	for _, state := range []string{circuitClosedState, circuitOpenState, circuitHalfOpenState} {
+		var value int64
+		if state == current {
+			value = 1
+		}
+		o.ObserveInt64(circuitBreakerStateGauge, value, metric.WithAttributes(attribute.String("state", state)))
+	}
+	return nil
+}
@@ -7,7 +7,7 @@ import (
 	"github.com/cybrarymin/gRPC/protogen/pb"
 )
 
-func (bca *BankGrpcClientAdapter) GetCurrentBalance(ctx context.Context, accountID string) (float64, error) {
+func (bca *BankGrpcClientAdapter) GetCurrentBalance(ctx context.Context, accountID string) (string, string, error) {
 	// calling function using our circuit breaker
 	resp, err := bca.circuitBreaker.Call(func() (any, error) {
 		return bca.client.GetCurrentBalance(ctx, &pb.CurrentBalanceRequest{
@@ -16,7 +16,7 @@ func (bca *BankGrpcClientAdapter) GetCurrentBalance(ctx context.Context, account
 	})
 
 	if err != nil {
-		return 0, err
+		return "", "", err
 	}
 
 	// Safe type assertion with ok check
@@ -26,8 +26,8 @@ func (bca *BankGrpcClientAdapter) GetCurrentBalance(ctx context.Context, account
 			Str("account_id", accountID).
 			Str("type", fmt.Sprintf("%T", resp)).
 			Msg("unexpected response type from circuit breaker")
-		return 0, fmt.Errorf("unexpected response type: %T", resp)
+		return "", "", fmt.Errorf("unexpected response type: %T", resp)
 	}
 
-	return balanceResp.CurrentBalance, nil
+	return pbMoneyToDecimal(balanceResp.CurrentBalance), balanceResp.Currency.String(), nil
 }
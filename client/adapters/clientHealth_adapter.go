@@ -0,0 +1,52 @@
+package client_adapters
+
+import (
+	"context"
+
+	client_ports "github.com/cybrarymin/gRPC/client/internals/domains/ports"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// The health calls don't go through the circuit breaker, a probe must report the server state even while the breaker is open.
+
+// CheckHealth returns the serving status of the service as json and whether the service is serving.
+// An empty service name checks the whole server.
+func (bca *BankGrpcClientAdapter) CheckHealth(ctx context.Context, service string) ([]byte, bool, error) {
+	resp, err := bca.health.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return nil, false, err
+	}
+
+	jsonResp, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(resp)
+	if err != nil {
+		return nil, false, err
+	}
+	return jsonResp, resp.GetStatus() == healthpb.HealthCheckResponse_SERVING, nil
+}
+
+type HealthWatchResponse struct {
+	stream grpc.ServerStreamingClient[healthpb.HealthCheckResponse]
+}
+
+func (hw *HealthWatchResponse) Next() ([]byte, error) {
+	resp, err := hw.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(resp)
+}
+
+func (hw *HealthWatchResponse) Close() error {
+	return hw.stream.CloseSend()
+}
+
+// WatchHealth streams the serving status of the service, starting with its current status
+func (bca *BankGrpcClientAdapter) WatchHealth(ctx context.Context, service string) (client_ports.HealthWatchResponsePort, error) {
+	stream, err := bca.health.Watch(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return nil, err
+	}
+	return &HealthWatchResponse{stream: stream}, nil
+}
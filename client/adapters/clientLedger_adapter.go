@@ -0,0 +1,29 @@
+package client_adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cybrarymin/gRPC/protogen/pb"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// VerifyLedger runs the server ledger verification and returns its report as json
+func (bca *BankGrpcClientAdapter) VerifyLedger(ctx context.Context) ([]byte, error) {
+	resp, err := bca.circuitBreaker.Call(func() (any, error) {
+		return bca.client.VerifyLedger(ctx, &pb.VerifyLedgerRequest{})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report, ok := resp.(*pb.VerifyLedgerResponse)
+	if !ok {
+		bca.logger.Error().
+			Str("type", fmt.Sprintf("%T", resp)).
+			Msg("unexpected response type from circuit breaker")
+		return nil, fmt.Errorf("unexpected response type: %T", resp)
+	}
+
+	return protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(report)
+}
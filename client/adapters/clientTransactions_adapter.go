@@ -0,0 +1,74 @@
+package client_adapters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	client_ports "github.com/cybrarymin/gRPC/client/internals/domains/ports"
+	"github.com/cybrarymin/gRPC/protogen/pb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListTransactions fetches a single page of transactions and returns it as json
+func (bca *BankGrpcClientAdapter) ListTransactions(ctx context.Context, filter client_ports.TransactionListFilter) ([]byte, error) {
+	req := &pb.ListTransactionsRequest{
+		AccountUUID: filter.AccountUUID,
+		PageSize:    int32(filter.PageSize),
+		PageToken:   filter.PageToken,
+	}
+
+	if filter.TransactionType != "" {
+		trType, exists := pb.TransactionType_value[filter.TransactionType]
+		if !exists {
+			return nil, fmt.Errorf("unsupported transaction type %s", filter.TransactionType)
+		}
+		req.TransactionType = pb.TransactionType(trType)
+	}
+	if filter.From != "" {
+		from, err := time.Parse(time.RFC3339, filter.From)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from timestamp: %w", err)
+		}
+		req.From = timestamppb.New(from)
+	}
+	if filter.To != "" {
+		to, err := time.Parse(time.RFC3339, filter.To)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to timestamp: %w", err)
+		}
+		req.To = timestamppb.New(to)
+	}
+	if filter.MinAmount != "" {
+		minAmount, err := newPbMoney(filter.Currency, filter.MinAmount)
+		if err != nil {
+			return nil, err
+		}
+		req.MinAmount = minAmount
+	}
+	if filter.MaxAmount != "" {
+		maxAmount, err := newPbMoney(filter.Currency, filter.MaxAmount)
+		if err != nil {
+			return nil, err
+		}
+		req.MaxAmount = maxAmount
+	}
+
+	resp, err := bca.circuitBreaker.Call(func() (any, error) {
+		return bca.client.ListTransactions(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	listResp, ok := resp.(*pb.ListTransactionsResponse)
+	if !ok {
+		bca.logger.Error().
+			Str("type", fmt.Sprintf("%T", resp)).
+			Msg("unexpected response type from circuit breaker")
+		return nil, fmt.Errorf("unexpected response type: %T", resp)
+	}
+
+	return protojson.Marshal(listResp)
+}
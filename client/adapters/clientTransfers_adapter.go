@@ -0,0 +1,80 @@
+package client_adapters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	client_ports "github.com/cybrarymin/gRPC/client/internals/domains/ports"
+	"github.com/cybrarymin/gRPC/protogen/pb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetTransfer fetches a transfer with its status history and returns it as json
+func (bca *BankGrpcClientAdapter) GetTransfer(ctx context.Context, transferUUID string) ([]byte, error) {
+	resp, err := bca.circuitBreaker.Call(func() (any, error) {
+		return bca.client.GetTransfer(ctx, &pb.GetTransferRequest{TransferUUID: transferUUID})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, ok := resp.(*pb.BankTransfer)
+	if !ok {
+		bca.logger.Error().
+			Str("type", fmt.Sprintf("%T", resp)).
+			Msg("unexpected response type from circuit breaker")
+		return nil, fmt.Errorf("unexpected response type: %T", resp)
+	}
+
+	return protojson.Marshal(transfer)
+}
+
+// ListTransfers fetches a single page of transfers and returns it as json
+func (bca *BankGrpcClientAdapter) ListTransfers(ctx context.Context, filter client_ports.TransferListFilter) ([]byte, error) {
+	req := &pb.ListTransfersRequest{
+		AccountUUID: filter.AccountUUID,
+		PageSize:    int32(filter.PageSize),
+		PageToken:   filter.PageToken,
+	}
+
+	if filter.Status != "" {
+		trStatus, exists := pb.TransferStatus_value[filter.Status]
+		if !exists {
+			return nil, fmt.Errorf("unsupported transfer status %s", filter.Status)
+		}
+		req.Status = pb.TransferStatus(trStatus)
+	}
+	if filter.From != "" {
+		from, err := time.Parse(time.RFC3339, filter.From)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from timestamp: %w", err)
+		}
+		req.From = timestamppb.New(from)
+	}
+	if filter.To != "" {
+		to, err := time.Parse(time.RFC3339, filter.To)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to timestamp: %w", err)
+		}
+		req.To = timestamppb.New(to)
+	}
+
+	resp, err := bca.circuitBreaker.Call(func() (any, error) {
+		return bca.client.ListTransfers(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	listResp, ok := resp.(*pb.ListTransfersResponse)
+	if !ok {
+		bca.logger.Error().
+			Str("type", fmt.Sprintf("%T", resp)).
+			Msg("unexpected response type from circuit breaker")
+		return nil, fmt.Errorf("unexpected response type: %T", resp)
+	}
+
+	return protojson.Marshal(listResp)
+}
@@ -4,11 +4,13 @@ import (
 	"github.com/cybrarymin/gRPC/protogen/pb"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type BankGrpcClientAdapter struct {
 	logger         *zerolog.Logger
 	client         pb.BankServiceClient
+	health         healthpb.HealthClient
 	circuitBreaker *CircuitBreaker
 }
 
@@ -19,6 +21,7 @@ func NewBankGrpcClientAdapter(conn *grpc.ClientConn, logger *zerolog.Logger, cb
 	return &BankGrpcClientAdapter{
 		logger:         logger,
 		client:         client,
+		health:         healthpb.NewHealthClient(conn),
 		circuitBreaker: cb,
 	}, nil
 }
@@ -0,0 +1,42 @@
+package client_adapters
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Client metrics. The instruments are created on the global meter provider and are only exported when ServeMetrics is called.
+var (
+	meter = otel.Meter("github.com/cybrarymin/gRPC/client/adapters")
+
+	circuitBreakerStateGauge, _ = meter.Int64ObservableGauge("bank.client.circuit_breaker.state",
+		metric.WithDescription("Current state of the circuit breaker, 1 for the current state and 0 for the others"))
+	circuitBreakerTransitionsCounter, _ = meter.Int64Counter("bank.client.circuit_breaker.transitions",
+		metric.WithDescription("Number of circuit breaker state changes"),
+		metric.WithUnit("{transition}"))
+	circuitBreakerRejectedCounter, _ = meter.Int64Counter("bank.client.circuit_breaker.rejected",
+		metric.WithDescription("Number of requests rejected by the open circuit breaker"),
+		metric.WithUnit("{request}"))
+)
+
+// ServeMetrics exports the metrics of the global meter provider on a prometheus scrape endpoint at addr/metrics for as long as the command runs
+func ServeMetrics(addr string, logger *zerolog.Logger) {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", promhttp.Handler())
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error().Err(err).Str("address", addr).Msg("client metrics server failed to serve")
+		}
+	}()
+}
@@ -0,0 +1,66 @@
+package client_adapters
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cybrarymin/gRPC/protogen/pb"
+)
+
+// newPbMoney converts a decimal amount such as "12.30" to the protobuf money message.
+// Precision against the currency minor unit is validated by the server.
+func newPbMoney(currency string, amount string) (*pb.Money, error) {
+	pbCurrency, exists := pb.Currency_value[currency]
+	if !exists {
+		return nil, fmt.Errorf("unsupported currency %s", currency)
+	}
+
+	amount = strings.TrimSpace(amount)
+	negative := strings.HasPrefix(amount, "-")
+	intPart, fracPart, _ := strings.Cut(strings.TrimPrefix(amount, "-"), ".")
+	if intPart == "" {
+		intPart = "0"
+	}
+	if len(fracPart) > 9 {
+		return nil, fmt.Errorf("amount %s has more than 9 decimal digits", amount)
+	}
+
+	units, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount %s: %w", amount, err)
+	}
+	nanos := int64(0)
+	if fracPart != "" {
+		nanos, err = strconv.ParseInt(fracPart+strings.Repeat("0", 9-len(fracPart)), 10, 32)
+		if err != nil || strings.HasPrefix(fracPart, "+") || strings.HasPrefix(fracPart, "-") {
+			return nil, fmt.Errorf("invalid amount %s", amount)
+		}
+	}
+	if negative {
+		units, nanos = -units, -nanos
+	}
+
+	return &pb.Money{
+		Currency: pb.Currency(pbCurrency),
+		Units:    units,
+		Nanos:    int32(nanos),
+	}, nil
+}
+
+// pbMoneyToDecimal formats the protobuf money message as a decimal string such as "12.3"
+func pbMoneyToDecimal(m *pb.Money) string {
+	if m == nil {
+		return "0"
+	}
+	units, nanos := m.Units, m.Nanos
+	sign := ""
+	if units < 0 || nanos < 0 {
+		sign = "-"
+		units, nanos = -units, -nanos
+	}
+	if nanos == 0 {
+		return sign + strconv.FormatInt(units, 10)
+	}
+	return sign + strconv.FormatInt(units, 10) + "." + strings.TrimRight(fmt.Sprintf("%09d", nanos), "0")
+}
@@ -0,0 +1,57 @@
+package client_adapters
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ClientTLSConfig configures the transport security of the connection to the gRPC server
+type ClientTLSConfig struct {
+	CAFile     string // CA bundle verifying the server certificate, the system roots are used when empty
+	CertFile   string // client certificate presented for mutual TLS
+	KeyFile    string
+	ServerName string // overrides the server name verified against the server certificate
+	Insecure   bool   // plaintext connection, only meant for local development
+}
+
+// NewClientTransportCredentials returns the grpc transport credentials described by the configuration
+func NewClientTransportCredentials(cfg ClientTLSConfig) (credentials.TransportCredentials, error) {
+	if cfg.Insecure {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsCfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.ServerName,
+	}
+
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the CA file: %w", err)
+		}
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("CA file %s doesn't contain any PEM certificate", cfg.CAFile)
+		}
+		tlsCfg.RootCAs = rootCAs
+	}
+
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return nil, fmt.Errorf("both the client certificate and key files are required for mutual TLS")
+	}
+	if cfg.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client certificate: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(tlsCfg), nil
+}
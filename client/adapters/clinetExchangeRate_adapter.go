@@ -37,12 +37,15 @@ func (exr *ExchangeRateStreamResponse) Close() error {
 	return nil
 }
 
-func (bca *BankGrpcClientAdapter) ShowExchangeRate(ctx context.Context, fromCurrency string, toCurrency string, amount float64) (client_ports.ExchangeRateStreamResponsePort, error) {
+func (bca *BankGrpcClientAdapter) ShowExchangeRate(ctx context.Context, fromCurrency string, toCurrency string, amount string) (client_ports.ExchangeRateStreamResponsePort, error) {
+	pbAmount, err := newPbMoney(fromCurrency, amount)
+	if err != nil {
+		return nil, err
+	}
 
 	req := &pb.ExchangeRateRequest{
-		FromCurrency: pb.Currency(pb.Currency_value[fromCurrency]),
-		ToCurrency:   pb.Currency(pb.Currency_value[toCurrency]),
-		Amount:       amount,
+		ToCurrency: pb.Currency(pb.Currency_value[toCurrency]),
+		Amount:     pbAmount,
 	}
 
 	streamResp, err := bca.circuitBreaker.Call(func() (any, error) {
@@ -9,7 +9,42 @@ type ExchangeRateStreamResponsePort interface {
 	Close() error
 }
 
+// HealthWatchResponsePort returns every serving status change of a watched service
+type HealthWatchResponsePort interface {
+	Next() ([]byte, error)
+	Close() error
+}
+
+// TransactionListFilter holds the optional filters of a transaction listing. Empty values are ignored.
+type TransactionListFilter struct {
+	AccountUUID     string
+	TransactionType string
+	From            string // RFC3339 timestamp
+	To              string // RFC3339 timestamp
+	Currency        string // currency of the amount range
+	MinAmount       string
+	MaxAmount       string
+	PageSize        int
+	PageToken       string
+}
+
+// TransferListFilter holds the optional filters of a transfer listing. Empty values are ignored.
+type TransferListFilter struct {
+	AccountUUID string
+	Status      string
+	From        string // RFC3339 timestamp
+	To          string // RFC3339 timestamp
+	PageSize    int
+	PageToken   string
+}
+
 type GrpcClientPort interface {
-	GetCurrentBalance(ctx context.Context, accountID string) (float64, error)
-	ShowExchangeRate(ctx context.Context, fromCurrency string, toCurrency string, amount float64) (ExchangeRateStreamResponsePort, error)
+	GetCurrentBalance(ctx context.Context, accountID string) (string, string, error)
+	ShowExchangeRate(ctx context.Context, fromCurrency string, toCurrency string, amount string) (ExchangeRateStreamResponsePort, error)
+	ListTransactions(ctx context.Context, filter TransactionListFilter) ([]byte, error)
+	GetTransfer(ctx context.Context, transferUUID string) ([]byte, error)
+	ListTransfers(ctx context.Context, filter TransferListFilter) ([]byte, error)
+	VerifyLedger(ctx context.Context) ([]byte, error)
+	CheckHealth(ctx context.Context, service string) ([]byte, bool, error)
+	WatchHealth(ctx context.Context, service string) (HealthWatchResponsePort, error)
 }
@@ -35,7 +35,7 @@ func (bcs *BankCliService) ShowCurrentBalance(pCtx context.Context, accUUID stri
 	ctx, cancel := context.WithCancel(pCtx)
 	defer cancel()
 
-	balance, err := bcs.port.GetCurrentBalance(ctx, accUUID)
+	balance, currency, err := bcs.port.GetCurrentBalance(ctx, accUUID)
 
 	if err != nil {
 		// err is coming from gRPC server. Which we have coded in gRPC server to use status.Error().
@@ -48,11 +48,11 @@ func (bcs *BankCliService) ShowCurrentBalance(pCtx context.Context, accUUID stri
 			Send()
 		//return
 	}
-	fmt.Printf(`{ "balance": %v}`, balance)
+	fmt.Printf(`{ "balance": %q, "currency": %q }`, balance, currency)
 
 }
 
-func (bcs *BankCliService) ShowExchangeRate(pCtx context.Context, fromCurrency string, toCurrency string, amount float64) {
+func (bcs *BankCliService) ShowExchangeRate(pCtx context.Context, fromCurrency string, toCurrency string, amount string) {
 	ctx, cancel := context.WithCancel(pCtx)
 	defer cancel()
 	streamResp, err := bcs.port.ShowExchangeRate(ctx, fromCurrency, toCurrency, amount)
@@ -64,22 +64,140 @@ func (bcs *BankCliService) ShowExchangeRate(pCtx context.Context, fromCurrency s
 			Str("fromCurrency", fromCurrency).
 			Str("toCurrency", toCurrency).
 			Send()
+		return
 	}
 	defer streamResp.Close()
 	for {
 		jsonResp, err := streamResp.Next()
 		if err != nil {
+			if err == io.EOF {
+				return
+			}
 			st := status.Convert(err)
 			bcs.logger.Error().Err(fmt.Errorf("%s", st.Message())).
 				Str("status", st.Code().String()).
 				Str("fromCurrency", fromCurrency).
 				Str("toCurrency", toCurrency).
 				Send()
+			return
+		}
+		fmt.Println(string(jsonResp))
+	}
+}
 
-			if err == io.EOF {
-				bcs.logger.Error().Err(fmt.Errorf("received unexpted response from the server"))
-				break
+// ListTransactions prints a page of transactions. The next_page_token of the output can be passed back to fetch the next page.
+func (bcs *BankCliService) ListTransactions(pCtx context.Context, filter client_ports.TransactionListFilter) {
+	ctx, cancel := context.WithCancel(pCtx)
+	defer cancel()
+
+	jsonResp, err := bcs.port.ListTransactions(ctx, filter)
+	if err != nil {
+		st := status.Convert(err)
+		bcs.logger.Error().Err(fmt.Errorf("%s", st.Message())).
+			Str("status", st.Code().String()).
+			Str("account_uuid", filter.AccountUUID).
+			Send()
+		return
+	}
+	fmt.Println(string(jsonResp))
+}
+
+// GetTransfer prints a transfer along with its status history
+func (bcs *BankCliService) GetTransfer(pCtx context.Context, transferUUID string) {
+	ctx, cancel := context.WithCancel(pCtx)
+	defer cancel()
+
+	jsonResp, err := bcs.port.GetTransfer(ctx, transferUUID)
+	if err != nil {
+		st := status.Convert(err)
+		bcs.logger.Error().Err(fmt.Errorf("%s", st.Message())).
+			Str("status", st.Code().String()).
+			Str("transfer_uuid", transferUUID).
+			Send()
+		return
+	}
+	fmt.Println(string(jsonResp))
+}
+
+// ListTransfers prints a page of transfers. The next_page_token of the output can be passed back to fetch the next page.
+func (bcs *BankCliService) ListTransfers(pCtx context.Context, filter client_ports.TransferListFilter) {
+	ctx, cancel := context.WithCancel(pCtx)
+	defer cancel()
+
+	jsonResp, err := bcs.port.ListTransfers(ctx, filter)
+	if err != nil {
+		st := status.Convert(err)
+		bcs.logger.Error().Err(fmt.Errorf("%s", st.Message())).
+			Str("status", st.Code().String()).
+			Str("account_uuid", filter.AccountUUID).
+			Send()
+		return
+	}
+	fmt.Println(string(jsonResp))
+}
+
+// VerifyLedger prints the ledger verification report listing every account whose stored balance differs from its postings
+func (bcs *BankCliService) VerifyLedger(pCtx context.Context) {
+	ctx, cancel := context.WithCancel(pCtx)
+	defer cancel()
+
+	jsonResp, err := bcs.port.VerifyLedger(ctx)
+	if err != nil {
+		st := status.Convert(err)
+		bcs.logger.Error().Err(fmt.Errorf("%s", st.Message())).
+			Str("status", st.Code().String()).
+			Send()
+		return
+	}
+	fmt.Println(string(jsonResp))
+}
+
+// CheckHealth prints the serving status of the service and reports whether it is serving
+func (bcs *BankCliService) CheckHealth(pCtx context.Context, service string) bool {
+	ctx, cancel := context.WithCancel(pCtx)
+	defer cancel()
+
+	jsonResp, serving, err := bcs.port.CheckHealth(ctx, service)
+	if err != nil {
+		st := status.Convert(err)
+		bcs.logger.Error().Err(fmt.Errorf("%s", st.Message())).
+			Str("status", st.Code().String()).
+			Str("service", service).
+			Send()
+		return false
+	}
+	fmt.Println(string(jsonResp))
+	return serving
+}
+
+// WatchHealth prints every serving status change of the service until the context is canceled or the server closes the stream
+func (bcs *BankCliService) WatchHealth(pCtx context.Context, service string) {
+	ctx, cancel := context.WithCancel(pCtx)
+	defer cancel()
+
+	watchResp, err := bcs.port.WatchHealth(ctx, service)
+	if err != nil {
+		st := status.Convert(err)
+		bcs.logger.Error().Err(fmt.Errorf("%s", st.Message())).
+			Str("status", st.Code().String()).
+			Str("service", service).
+			Send()
+		return
+	}
+	defer watchResp.Close()
+
+	for {
+		jsonResp, err := watchResp.Next()
+		if err != nil {
+			if err == io.EOF || ctx.Err() != nil {
+				return
 			}
+			st := status.Convert(err)
+			bcs.logger.Error().Err(fmt.Errorf("%s", st.Message())).
+				Str("status", st.Code().String()).
+				Str("service", service).
+				Send()
+			return
 		}
 		fmt.Println(string(jsonResp))
 	}
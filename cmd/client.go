@@ -4,6 +4,8 @@ Copyright © 2025 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
+	"context"
+	"os"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -17,6 +19,17 @@ var (
 	CBHalfOpenMaxRequests   int
 	CBRequestTimeout        time.Duration
 	CBOpenRecoveryTime      time.Duration
+	clientTLSCAFile         string
+	clientTLSCertFile       string
+	clientTLSKeyFile        string
+	clientTLSServerName     string
+	clientInsecure          bool
+	clientAuthToken         string
+	clientAPIKey            string
+	clientMetricsAddr       string
+
+	// clientTelemetryShutdown flushes the client spans, it's set once the client is created
+	clientTelemetryShutdown func(context.Context) error
 )
 
 // clientCmd represents the client command
@@ -28,6 +41,19 @@ var clientCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		cmd.Help()
 	},
+	PersistentPostRun: func(cmd *cobra.Command, args []string) {
+		flushClientTelemetry()
+	},
+}
+
+// flushClientTelemetry exports the pending client spans before the command exits
+func flushClientTelemetry() {
+	if clientTelemetryShutdown == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	clientTelemetryShutdown(ctx)
 }
 
 func init() {
@@ -39,4 +65,12 @@ func init() {
 	clientCmd.PersistentFlags().IntVar(&CBHalfOpenMaxRequests, "cb-halfopen-max-request", 5, "circuit breaker successful request threshold to change the half-open state to closed")
 	clientCmd.PersistentFlags().DurationVar(&CBRequestTimeout, "cb-request-timeouts", time.Second*10, "timeouts for requests generated by client and haven't get any response")
 	clientCmd.PersistentFlags().DurationVar(&CBOpenRecoveryTime, "cb-recovery-time", time.Second*5, "duration to block the requests before moving from open state to half-open state")
+	clientCmd.PersistentFlags().StringVar(&clientTLSCAFile, "tls-ca", "", "CA bundle verifying the grpc server certificate, defaults to the system roots")
+	clientCmd.PersistentFlags().StringVar(&clientTLSCertFile, "tls-cert", "", "client certificate file for mutual TLS")
+	clientCmd.PersistentFlags().StringVar(&clientTLSKeyFile, "tls-key", "", "client private key file for mutual TLS")
+	clientCmd.PersistentFlags().StringVar(&clientTLSServerName, "tls-server-name", "", "server name to verify the grpc server certificate against, defaults to --grpc-host")
+	clientCmd.PersistentFlags().BoolVar(&clientInsecure, "insecure", false, "connect without TLS, only for local development")
+	clientCmd.PersistentFlags().StringVar(&clientAuthToken, "auth-token", os.Getenv("BANK_AUTH_TOKEN"), "JWT bearer token sent with every call, defaults to $BANK_AUTH_TOKEN")
+	clientCmd.PersistentFlags().StringVar(&clientMetricsAddr, "metrics-addr", "", "address serving the client metrics, e.g. circuit breaker state, on /metrics while the command runs. Empty disables the endpoint")
+	clientCmd.PersistentFlags().StringVar(&clientAPIKey, "api-key", os.Getenv("BANK_API_KEY"), "api key sent with every call, defaults to $BANK_API_KEY")
 }
@@ -0,0 +1,49 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	healthCmd_Service string
+	healthCmd_Watch   bool
+)
+
+// healthCmd represents the health command
+var healthCmd = &cobra.Command{
+	Use:   "health",
+	Short: "Check the serving status of the grpc server",
+	Long: `Query the standard grpc.health.v1 service of the server. The command exits with a non-zero code
+when the service isn't serving. With --watch every status change is printed until interrupted.
+The server reports NOT_SERVING while its database is unreachable and during shutdown.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		cli_service, err := client()
+		if err != nil {
+			os.Exit(1)
+		}
+		if healthCmd_Watch {
+			cli_service.WatchHealth(ctx, healthCmd_Service)
+			return
+		}
+		if !cli_service.CheckHealth(ctx, healthCmd_Service) {
+			flushClientTelemetry()
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(healthCmd)
+	healthCmd.Flags().StringVar(&healthCmd_Service, "service", "", "service to check, e.g. bank.BankService, defaults to the whole server")
+	healthCmd.Flags().BoolVar(&healthCmd_Watch, "watch", false, "stream the serving status changes instead of checking once")
+}
@@ -0,0 +1,40 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+)
+
+// ledgerCmd groups the ledger administration commands
+var ledgerCmd = &cobra.Command{
+	Use:   "ledger",
+	Short: "Administer the double-entry ledger",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+// ledgerVerifyCmd represents the ledger verify command
+var ledgerVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Check account balances against the ledger",
+	Long: `Compare the stored balance of every bank account with the sum of its ledger postings and
+look for journal entries which don't balance. The report lists every account whose balance differs.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		cli_service, err := client()
+		if err != nil {
+			return
+		}
+		cli_service.VerifyLedger(ctx)
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(ledgerCmd)
+	ledgerCmd.AddCommand(ledgerVerifyCmd)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
@@ -15,11 +16,15 @@ import (
 	client_services "github.com/cybrarymin/gRPC/client/internals/domains/services"
 	data "github.com/cybrarymin/gRPC/data/migrations"
 	repoadapters "github.com/cybrarymin/gRPC/server/internals/adapters/driven_adapters/database"
+	rateadapters "github.com/cybrarymin/gRPC/server/internals/adapters/driven_adapters/rateproviders"
+	gatewayadapters "github.com/cybrarymin/gRPC/server/internals/adapters/driving_adapters/gateway"
 	adapters "github.com/cybrarymin/gRPC/server/internals/adapters/driving_adapters/grpc"
+	metricsadapters "github.com/cybrarymin/gRPC/server/internals/adapters/driving_adapters/metrics"
+	ports "github.com/cybrarymin/gRPC/server/internals/domains/ports"
 	domains "github.com/cybrarymin/gRPC/server/internals/domains/service"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 var (
@@ -28,6 +33,41 @@ var (
 	FlagDBMaxIdleConnCount   int
 	FlagDBMaxIdleConnTimeout time.Duration
 	FlagDBDSN                string
+	FlagTLSCertFile          string
+	FlagTLSKeyFile           string
+	FlagTLSClientCAFile      string
+	FlagTLSRequireClientCert bool
+	FlagInsecure             bool
+	FlagAuthJWKSFile         string
+	FlagAuthJWTIssuer        string
+	FlagAuthJWTAudience      string
+	FlagAuthAPIKeysFile      string
+	FlagAuthDisabled         bool
+	FlagRateLimitFile        string
+	FlagGatewayPort          string
+	FlagMetricsPort          string
+	FlagDemoRates            bool
+	FlagRateECBFile          string
+	FlagRateCSVFile          string
+	FlagRateHTTPURL          string
+	FlagRateHTTPTimeout      time.Duration
+	FlagRatePollInterval     time.Duration
+	FlagRateValidity         time.Duration
+	FlagRatePivotCurrency    string
+
+	FlagOTelTracesExporter        string
+	FlagOTelOTLPProtocol          string
+	FlagOTelOTLPEndpoint          string
+	FlagOTelOTLPInsecure          bool
+	FlagOTelOTLPTimeout           time.Duration
+	FlagOTelSamplerRatio          float64
+	FlagOTelServiceName           string
+	FlagOTelDeploymentEnvironment string
+)
+
+const (
+	serverServiceName = "bankservice"
+	clientServiceName = "bankservice-cli"
 )
 
 func main() {
@@ -43,6 +83,14 @@ func main() {
 	} else {
 		logger = zerolog.New(os.Stdout).With().Timestamp().Logger().Level(loglvl)
 	}
+	// the events logged with a request context carry its request, trace and span ids
+	logger = logger.Hook(adapters.RequestLogHook{})
+
+	// Setup openTelemetry
+	otelShutdown, err := repoadapters.SetupOTelSDK(ctx, otelConfig(serverServiceName, &logger)) // Calling setupOTelSDK to initialize the traceProvider and meterProvider before any instrumented component
+	if err != nil {
+		logger.Panic().Msgf("couldn't set up opentelemetry: %s", err.Error())
+	}
 
 	// Create new bankaccountRepository
 	dbCfg := repoadapters.DbConfig{
@@ -58,49 +106,144 @@ func main() {
 		logger.Panic().Msgf("couldn't establish database connection: %s", err.Error())
 	}
 
-	// Setup openTelemetry
-	otelShutdown, err := repoadapters.SetupOTelSDK(ctx) // Calling setupOTelSDK to initialize the traceProvider
-	if err != nil {
-		logger.Error().Err(err)
-	}
-
-	// Create new validator
-	v := domains.NewValidator()
-
 	// Create new repository for invoking the CRUD operations on our backend database
 	postgresBankAccountRepo := repoadapters.NewBankAccountRepository(db, &logger)
 	postgresTransactionRepo := repoadapters.NewBankTransactionRepository(db, &logger)
 	postgresExchangeRateRepo := repoadapters.NewBankExchangeRateRepository(db, &logger)
 	postgresTransferRepo := repoadapters.NewBankTransferRepository(db, &logger)
+	postgresIdempotencyKeyRepo := repoadapters.NewIdempotencyKeyRepository(db, &logger)
+	postgresLedgerRepo := repoadapters.NewLedgerRepository(db, &logger)
+	postgresHealthRepo := repoadapters.NewHealthRepository(db, &logger)
+
+	// Create new unit of work to run multiple repository operations inside a single database transaction
+	postgresUnitOfWork := repoadapters.NewUnitOfWork(db, &logger)
 
 	// Create new domain bank account service. This domain service is the type of BankAccountGrpcPort so we will give it to GRPC adapter
-	domainBankAccountService := domains.NewBankAccountService(postgresBankAccountRepo, &logger, &v)
-	domainTransactionService := domains.NewTransactionService(postgresTransactionRepo, postgresBankAccountRepo, &logger)
-	domainExchangeRateService := domains.NewBankExchangeRateService(postgresExchangeRateRepo, &logger)
-	domainTransferService := domains.NewBankTransferService(postgresTransferRepo, postgresBankAccountRepo, postgresTransactionRepo, postgresExchangeRateRepo, &logger, &v)
+	domainBankAccountService := domains.NewBankAccountService(postgresBankAccountRepo, postgresUnitOfWork, postgresLedgerRepo, &logger)
+	domainTransactionService := domains.NewTransactionService(postgresTransactionRepo, postgresBankAccountRepo, postgresUnitOfWork, postgresIdempotencyKeyRepo, postgresLedgerRepo, &logger)
+	rateResolver, err := domains.NewRateResolver(postgresExchangeRateRepo, FlagRatePivotCurrency, &logger)
+	if err != nil {
+		logger.Panic().Msgf("couldn't use %q as the exchange rate pivot currency: %s", FlagRatePivotCurrency, err.Error())
+	}
+	rateBroadcaster := domains.NewRateBroadcaster(&logger)
+	domainExchangeRateService := domains.NewBankExchangeRateService(postgresExchangeRateRepo, rateResolver, rateBroadcaster, &logger)
+	domainTransferService := domains.NewBankTransferService(postgresTransferRepo, postgresBankAccountRepo, postgresTransactionRepo, rateResolver, postgresUnitOfWork, postgresIdempotencyKeyRepo, postgresLedgerRepo, &logger)
+	domainLedgerService := domains.NewLedgerService(postgresLedgerRepo, &logger)
+	domainHealthService := domains.NewHealthService(postgresHealthRepo, &logger)
+
+	var tlsCfg *adapters.TLSConfig
+	if !FlagInsecure {
+		tlsCfg = &adapters.TLSConfig{
+			CertFile:          FlagTLSCertFile,
+			KeyFile:           FlagTLSKeyFile,
+			ClientCAFile:      FlagTLSClientCAFile,
+			RequireClientCert: FlagTLSRequireClientCert,
+		}
+	}
+
+	var authn adapters.Authenticator
+	if !FlagAuthDisabled {
+		authn, err = adapters.NewAuthenticator(adapters.AuthConfig{
+			JWKSFile:    FlagAuthJWKSFile,
+			JWTIssuer:   FlagAuthJWTIssuer,
+			JWTAudience: FlagAuthJWTAudience,
+			APIKeysFile: FlagAuthAPIKeysFile,
+		})
+		if err != nil {
+			logger.Panic().Msgf("couldn't load the grpc server authentication configuration: %s", err.Error())
+		}
+	}
+
+	var limiter *adapters.RateLimiter
+	if FlagRateLimitFile != "" {
+		limiter, err = adapters.NewRateLimiter(FlagRateLimitFile, &logger)
+		if err != nil {
+			logger.Panic().Msgf("couldn't load the grpc server rate limits: %s", err.Error())
+		}
+	}
 
 	// Create new grp
-	grpcAdapter := adapters.NewGrpcAdapter("0.0.0.0", "9090", &logger, adapters.GrpcPortReference{
-		domainBankAccountService,
-		domainTransactionService,
-		domainExchangeRateService,
-		domainTransferService,
-		&v,
-	})
+	grpcAdapter, err := adapters.NewGrpcAdapter("0.0.0.0", "9090", &logger, adapters.GrpcPortReference{
+		BankAccountGrpcPort:      domainBankAccountService,
+		TransactionGrpcPort:      domainTransactionService,
+		BankExchangeRateGrpcPort: domainExchangeRateService,
+		BankTransferGrpcPort:     domainTransferService,
+		LedgerGrpcPort:           domainLedgerService,
+		HealthGrpcPort:           domainHealthService,
+	}, tlsCfg, authn, limiter)
+	if err != nil {
+		logger.Panic().Msgf("couldn't create the grpc server: %s", err.Error())
+	}
 
-	// Use dynamic exchange rate updater as a dummy data sampler
-	dRateChanger := data.NewDynamicExchangeRate(postgresExchangeRateRepo, &logger)
+	// Create the REST/JSON gateway forwarding the http requests to the grpc server through an in-memory connection
+	stopFuncs := []func(context.Context) error{}
+	if FlagGatewayPort != "" {
+		gatewayConn, err := grpcAdapter.DialInProcess()
+		if err != nil {
+			logger.Panic().Msgf("couldn't connect the http gateway to the grpc server: %s", err.Error())
+		}
+		gatewayAdapter, err := gatewayadapters.NewGatewayAdapter("0.0.0.0", FlagGatewayPort, &logger, gatewayConn, grpcAdapter.GatewayTLSConfig())
+		if err != nil {
+			logger.Panic().Msgf("couldn't create the http gateway: %s", err.Error())
+		}
+		go gatewayAdapter.Run()
+		stopFuncs = append(stopFuncs, gatewayAdapter.Stop)
+	}
+	stopFuncs = append(stopFuncs, grpcAdapter.Stop)
+
+	// Serve the prometheus scrape endpoint
+	if FlagMetricsPort != "" {
+		metricsAdapter := metricsadapters.NewMetricsAdapter("0.0.0.0", FlagMetricsPort, &logger)
+		go metricsAdapter.Run()
+		stopFuncs = append(stopFuncs, metricsAdapter.Stop)
+	}
+	stopFuncs = append(stopFuncs, otelShutdown)
+
+	// Push the exchange rate changes written by any replica to the exchange rate streams
+	exchangeRateListener := repoadapters.NewBankExchangeRateListener(db, &logger)
 	BackgroundJob(func() {
-		dRateChanger.ChangeExchangeRates(ctx)
-	}, "dynamic exchange rate changer paniced", &logger)
+		exchangeRateListener.Listen(ctx, rateBroadcaster.Publish)
+	}, "exchange rate listener paniced", &logger)
+
+	// Write the exchange rates published by the configured providers
+	rateProviders := []ports.RateProviderPort{}
+	if FlagRateECBFile != "" {
+		rateProviders = append(rateProviders, rateadapters.NewECBFileProvider(FlagRateECBFile, &logger))
+	}
+	if FlagRateCSVFile != "" {
+		rateProviders = append(rateProviders, rateadapters.NewCSVFileProvider(FlagRateCSVFile, &logger))
+	}
+	if FlagRateHTTPURL != "" {
+		rateProviders = append(rateProviders, rateadapters.NewHTTPJSONProvider(FlagRateHTTPURL, FlagRateHTTPTimeout, &logger))
+	}
+	if len(rateProviders) > 0 {
+		rateScheduler := domains.NewRateScheduler(postgresExchangeRateRepo, rateProviders, domains.RateSchedulerConfig{
+			PollInterval: FlagRatePollInterval,
+			Validity:     FlagRateValidity,
+		}, &logger)
+		BackgroundJob(func() {
+			rateScheduler.Run(ctx)
+		}, "exchange rate scheduler paniced", &logger)
+	}
+
+	// Use dynamic exchange rate updater as a dummy data sampler, only for demos
+	if FlagDemoRates {
+		if len(rateProviders) > 0 {
+			logger.Warn().Msg("demo exchange rates are enabled alongside rate providers, the sampled rates overwrite the provided ones")
+		}
+		dRateChanger := data.NewDynamicExchangeRate(postgresExchangeRateRepo, &logger)
+		BackgroundJob(func() {
+			dRateChanger.ChangeExchangeRates(ctx)
+		}, "dynamic exchange rate changer paniced", &logger)
+	}
 
 	shutdownErrs := make(chan error)
-	go GracefulShutdown(shutdownErrs, &logger, grpcAdapter.Stop, otelShutdown)
+	go GracefulShutdown(shutdownErrs, &logger, stopFuncs...)
 	grpcAdapter.Run()
 
 	err = <-shutdownErrs
 	if err != nil {
-		logger.Error().Err(err)
+		logger.Error().Err(err).Msg("graceful shutdown failed")
 	}
 
 }
@@ -130,17 +273,50 @@ func client() (*client_services.BankCliService, error) {
 		}
 	}
 
-	conn, err := grpc.NewClient("localhost:9090",
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	creds, err := client_adapters.NewClientTransportCredentials(client_adapters.ClientTLSConfig{
+		CAFile:     clientTLSCAFile,
+		CertFile:   clientTLSCertFile,
+		KeyFile:    clientTLSKeyFile,
+		ServerName: clientTLSServerName,
+		Insecure:   clientInsecure,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("couldn't load the grpc client tls configuration")
+		return nil, err
+	}
+
+	// Setup openTelemetry so the calls are traced as client spans and the trace context is propagated to the server
+	clientTelemetryShutdown, err = repoadapters.SetupOTelSDK(context.Background(), otelConfig(clientServiceName, &logger))
+	if err != nil {
+		logger.Error().Err(err).Msg("couldn't set up opentelemetry")
+		return nil, err
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 		grpc.WithDefaultServiceConfig(string(policyConfig)),
 		grpc.WithChainUnaryInterceptor(client_adapters.BasicClientUnaryInterceptor()),
-	)
+	}
+	if authCreds := client_adapters.NewPerRPCCredentials(client_adapters.ClientAuthConfig{
+		Token:    clientAuthToken,
+		APIKey:   clientAPIKey,
+		Insecure: clientInsecure,
+	}); authCreds != nil {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(authCreds))
+	}
+
+	conn, err := grpc.NewClient(net.JoinHostPort(clientCmdGrpcHost, clientCmdGrpcPort), dialOpts...)
 
 	if err != nil {
 		logger.Error().Err(err).Msg("couldn't establish connection to the grpc server")
 		return nil, err
 	}
 
+	if clientMetricsAddr != "" {
+		client_adapters.ServeMetrics(clientMetricsAddr, &logger)
+	}
+
 	// create a new circuit breaker for this client
 	newCb := client_adapters.NewCircuitBreaker(CBFailureThreshold, CBOpenRecoveryTime, CBHalfOpenMaxRequests, CBRequestTimeout, &logger)
 	// create new client adapter
@@ -157,6 +333,25 @@ func client() (*client_services.BankCliService, error) {
 
 }
 
+// otelConfig returns the telemetry configuration of the flags, the service name defaults to the name of the running side
+func otelConfig(defaultServiceName string, logger *zerolog.Logger) repoadapters.OTelConfig {
+	serviceName := FlagOTelServiceName
+	if serviceName == "" {
+		serviceName = defaultServiceName
+	}
+	return repoadapters.OTelConfig{
+		TracesExporter:        FlagOTelTracesExporter,
+		OTLPProtocol:          FlagOTelOTLPProtocol,
+		OTLPEndpoint:          FlagOTelOTLPEndpoint,
+		OTLPInsecure:          FlagOTelOTLPInsecure,
+		OTLPTimeout:           FlagOTelOTLPTimeout,
+		SamplerRatio:          FlagOTelSamplerRatio,
+		ServiceName:           serviceName,
+		DeploymentEnvironment: FlagOTelDeploymentEnvironment,
+		Logger:                logger,
+	}
+}
+
 func BackgroundJob(nfunc func(), PanicErrMsg string, logger *zerolog.Logger) {
 	go func() {
 		defer func() {
@@ -179,13 +374,12 @@ func GracefulShutdown(shutdownErrs chan error, logger *zerolog.Logger, stopFuncs
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
 	defer cancel()
+	// every component gets stopped even when a former one fails, the failures are reported together
+	var errs error
 	for _, stopFunc := range stopFuncs {
-		err := stopFunc(ctx)
-		if err != nil {
-			shutdownErrs <- err
-		}
+		errs = errors.Join(errs, stopFunc(ctx))
 	}
-	shutdownErrs <- nil
+	shutdownErrs <- errs
 
 	logger.Info().Msg("stopped the server...")
 }
@@ -5,6 +5,8 @@ package cmd
 
 import (
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -45,5 +47,50 @@ func init() {
 	// Cobra also supports local flags, which will only run
 	// when this action is called directly.
 	rootCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+	rootCmd.Flags().StringVar(&FlagTLSCertFile, "tls-cert", "", "grpc server certificate file, reloaded when the file changes")
+	rootCmd.Flags().StringVar(&FlagTLSKeyFile, "tls-key", "", "grpc server private key file, reloaded when the file changes")
+	rootCmd.Flags().StringVar(&FlagTLSClientCAFile, "tls-client-ca", "", "CA bundle verifying the client certificates, reloaded when the file changes")
+	rootCmd.Flags().BoolVar(&FlagTLSRequireClientCert, "tls-require-client-cert", false, "reject clients without a certificate signed by --tls-client-ca (mutual TLS)")
+	rootCmd.Flags().BoolVar(&FlagInsecure, "insecure", false, "serve plaintext without TLS, only for local development")
+	rootCmd.Flags().StringVar(&FlagAuthJWKSFile, "auth-jwks-file", "", "JSON Web Key Set file verifying the HS and RS signed JWT bearer tokens")
+	rootCmd.Flags().StringVar(&FlagAuthJWTIssuer, "auth-jwt-issuer", "", "expected iss claim of the JWT bearer tokens")
+	rootCmd.Flags().StringVar(&FlagAuthJWTAudience, "auth-jwt-audience", "", "expected aud claim of the JWT bearer tokens")
+	rootCmd.Flags().StringVar(&FlagAuthAPIKeysFile, "auth-api-keys-file", "", "static api keys file")
+	rootCmd.Flags().BoolVar(&FlagAuthDisabled, "auth-disabled", false, "accept requests without credentials, only for local development")
+	rootCmd.Flags().StringVar(&FlagRateLimitFile, "rate-limit-config", "", "per client rate limits and stream caps file, reloaded when the file changes")
+	rootCmd.Flags().StringVar(&FlagGatewayPort, "gateway-port", "8080", "port of the REST/JSON gateway, served with the grpc server tls configuration. Empty disables the gateway")
+	rootCmd.Flags().StringVar(&FlagMetricsPort, "metrics-port", "9464", "port of the prometheus scrape endpoint /metrics. Empty disables the endpoint")
+	rootCmd.Flags().BoolVar(&FlagDemoRates, "demo-rates", false, "move the stored exchange rates by a small random drift every 30s, only for demos")
+	rootCmd.Flags().StringVar(&FlagRateECBFile, "rate-ecb-file", "", "ECB style daily reference rates XML file, fetched again when the file changes")
+	rootCmd.Flags().StringVar(&FlagRateCSVFile, "rate-csv-file", "", "exchange rates CSV file with from,to,rate records, fetched again when the file changes")
+	rootCmd.Flags().StringVar(&FlagRateHTTPURL, "rate-http-url", "", "url of a JSON exchange rates endpoint answering {\"base\": \"EUR\", \"rates\": {\"USD\": \"1.08\"}}")
+	rootCmd.Flags().DurationVar(&FlagRateHTTPTimeout, "rate-http-timeout", 10*time.Second, "timeout of the requests to --rate-http-url")
+	rootCmd.Flags().DurationVar(&FlagRatePollInterval, "rate-poll-interval", time.Minute, "time between two fetches of every exchange rate provider")
+	rootCmd.Flags().DurationVar(&FlagRateValidity, "rate-validity", 10*time.Minute, "validity window of the provided exchange rates, a rate not refreshed in time becomes stale")
+	rootCmd.Flags().StringVar(&FlagRatePivotCurrency, "rate-pivot-currency", "EUR", "currency the pairs without a stored rate are crossed through, e.g. USD. Empty disables the cross rates")
+	rootCmd.PersistentFlags().StringVar(&FlagOTelTracesExporter, "otel-traces-exporter", envOr("OTEL_TRACES_EXPORTER", "otlp"), "traces exporter: otlp, console, stdout or none, defaults to $OTEL_TRACES_EXPORTER")
+	rootCmd.PersistentFlags().StringVar(&FlagOTelOTLPProtocol, "otel-exporter-otlp-protocol", envOr("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL", envOr("OTEL_EXPORTER_OTLP_PROTOCOL", "http/protobuf")), "otlp protocol: grpc or http/protobuf, defaults to $OTEL_EXPORTER_OTLP_PROTOCOL")
+	rootCmd.PersistentFlags().StringVar(&FlagOTelOTLPEndpoint, "otel-exporter-otlp-endpoint", "", "otlp collector url, e.g. http://localhost:4318. Unset uses $OTEL_EXPORTER_OTLP_ENDPOINT or the exporter default")
+	rootCmd.PersistentFlags().BoolVar(&FlagOTelOTLPInsecure, "otel-exporter-otlp-insecure", false, "send the spans to the otlp collector without TLS, also enabled by $OTEL_EXPORTER_OTLP_INSECURE")
+	rootCmd.PersistentFlags().DurationVar(&FlagOTelOTLPTimeout, "otel-exporter-otlp-timeout", 0, "otlp export timeout. Unset uses $OTEL_EXPORTER_OTLP_TIMEOUT or 10s")
+	rootCmd.PersistentFlags().Float64Var(&FlagOTelSamplerRatio, "otel-sampler-ratio", envFloatOr("OTEL_TRACES_SAMPLER_ARG", 1), "ratio of the new traces recorded, spans with a remote parent follow its decision. Defaults to $OTEL_TRACES_SAMPLER_ARG")
+	rootCmd.PersistentFlags().StringVar(&FlagOTelServiceName, "otel-service-name", os.Getenv("OTEL_SERVICE_NAME"), "service.name resource attribute, defaults to $OTEL_SERVICE_NAME, then bankservice for the server and bankservice-cli for the client")
+	rootCmd.PersistentFlags().StringVar(&FlagOTelDeploymentEnvironment, "otel-deployment-environment", "", "deployment.environment resource attribute, e.g. production. More attributes can be set with $OTEL_RESOURCE_ATTRIBUTES")
 	rootCmd.PersistentFlags().StringVar(&FlagLogLevel, "log-level", "info", "application log level: debug, info, warn, error, fatal, panic, trace, disabled")
 }
+
+// envOr returns the value of the environment variable or the fallback when it's unset
+func envOr(key string, fallback string) string {
+	if value, exists := os.LookupEnv(key); exists && value != "" {
+		return value
+	}
+	return fallback
+}
+
+// envFloatOr returns the numeric value of the environment variable or the fallback when it's unset or not a number
+func envFloatOr(key string, fallback float64) float64 {
+	if value, err := strconv.ParseFloat(os.Getenv(key), 64); err == nil {
+		return value
+	}
+	return fallback
+}
@@ -25,7 +25,7 @@ to quickly create a Cobra application.`,
 		if err != nil {
 			return
 		}
-		cli_service.ShowExchangeRate(ctx, "USD", "CAD", 10)
+		cli_service.ShowExchangeRate(ctx, "USD", "CAD", "10")
 	},
 }
 
@@ -0,0 +1,54 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"context"
+
+	client_ports "github.com/cybrarymin/gRPC/client/internals/domains/ports"
+	"github.com/spf13/cobra"
+)
+
+var (
+	transactionsListCmd_Filter client_ports.TransactionListFilter
+)
+
+// transactionsCmd groups the transaction history commands
+var transactionsCmd = &cobra.Command{
+	Use:   "transactions",
+	Short: "Inspect bank transactions",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+// transactionsListCmd represents the transactions list command
+var transactionsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List transactions, newest first",
+	Long: `List transactions page by page, newest first. All the filters are optional.
+The output contains a next_page_token which can be passed to --page-token to fetch the next page.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		cli_service, err := client()
+		if err != nil {
+			return
+		}
+		cli_service.ListTransactions(ctx, transactionsListCmd_Filter)
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(transactionsCmd)
+	transactionsCmd.AddCommand(transactionsListCmd)
+	transactionsListCmd.Flags().StringVar(&transactionsListCmd_Filter.AccountUUID, "account_uuid", "", "uuid of the account to list the transactions of")
+	transactionsListCmd.Flags().StringVar(&transactionsListCmd_Filter.TransactionType, "type", "", "transaction type: Refund, Payment, Transfer, Deposit, Withdraw")
+	transactionsListCmd.Flags().StringVar(&transactionsListCmd_Filter.From, "from", "", "list transactions at or after this RFC3339 timestamp")
+	transactionsListCmd.Flags().StringVar(&transactionsListCmd_Filter.To, "to", "", "list transactions before this RFC3339 timestamp")
+	transactionsListCmd.Flags().StringVar(&transactionsListCmd_Filter.Currency, "currency", "", "currency of the amount range")
+	transactionsListCmd.Flags().StringVar(&transactionsListCmd_Filter.MinAmount, "min-amount", "", "minimum transaction amount, e.g. 10.50")
+	transactionsListCmd.Flags().StringVar(&transactionsListCmd_Filter.MaxAmount, "max-amount", "", "maximum transaction amount, e.g. 100")
+	transactionsListCmd.Flags().IntVar(&transactionsListCmd_Filter.PageSize, "page-size", 50, "number of transactions per page, at most 500")
+	transactionsListCmd.Flags().StringVar(&transactionsListCmd_Filter.PageToken, "page-token", "", "next_page_token of the previous page")
+}
@@ -0,0 +1,72 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"context"
+
+	client_ports "github.com/cybrarymin/gRPC/client/internals/domains/ports"
+	"github.com/spf13/cobra"
+)
+
+var (
+	transfersGetCmd_TransferUUID string
+	transfersListCmd_Filter      client_ports.TransferListFilter
+)
+
+// transfersCmd groups the transfer inspection commands
+var transfersCmd = &cobra.Command{
+	Use:   "transfers",
+	Short: "Inspect money transfers",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+// transfersGetCmd represents the transfers get command
+var transfersGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Show a transfer and its status history",
+	Long: `Show a transfer along with every status it went through, when each step happened
+and, for failed transfers, why the transfer failed.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		cli_service, err := client()
+		if err != nil {
+			return
+		}
+		cli_service.GetTransfer(ctx, transfersGetCmd_TransferUUID)
+	},
+}
+
+// transfersListCmd represents the transfers list command
+var transfersListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List transfers, newest first",
+	Long: `List transfers page by page, newest first. All the filters are optional.
+The output contains a next_page_token which can be passed to --page-token to fetch the next page.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		cli_service, err := client()
+		if err != nil {
+			return
+		}
+		cli_service.ListTransfers(ctx, transfersListCmd_Filter)
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(transfersCmd)
+	transfersCmd.AddCommand(transfersGetCmd)
+	transfersCmd.AddCommand(transfersListCmd)
+
+	transfersGetCmd.Flags().StringVar(&transfersGetCmd_TransferUUID, "transfer_uuid", "", "uuid of the transfer")
+
+	transfersListCmd.Flags().StringVar(&transfersListCmd_Filter.AccountUUID, "account_uuid", "", "uuid of an account sending or receiving the transfers")
+	transfersListCmd.Flags().StringVar(&transfersListCmd_Filter.Status, "status", "", "transfer status: Pending, Debited, Completed, Failed, Reversed")
+	transfersListCmd.Flags().StringVar(&transfersListCmd_Filter.From, "from", "", "list transfers at or after this RFC3339 timestamp")
+	transfersListCmd.Flags().StringVar(&transfersListCmd_Filter.To, "to", "", "list transfers before this RFC3339 timestamp")
+	transfersListCmd.Flags().IntVar(&transfersListCmd_Filter.PageSize, "page-size", 50, "number of transfers per page, at most 500")
+	transfersListCmd.Flags().StringVar(&transfersListCmd_Filter.PageToken, "page-token", "", "next_page_token of the previous page")
+}
@@ -0,0 +1,3 @@
+ALTER TABLE bank_accounts DROP CONSTRAINT IF EXISTS bank_accounts_current_balance_check;
+ALTER TABLE bank_accounts DROP CONSTRAINT IF EXISTS bank_accounts_overdraft_limit_check;
+ALTER TABLE bank_accounts DROP COLUMN IF EXISTS overdraft_limit;
@@ -0,0 +1,3 @@
+ALTER TABLE bank_accounts ADD COLUMN IF NOT EXISTS overdraft_limit NUMERIC(15,2) NOT NULL DEFAULT 0;
+ALTER TABLE bank_accounts ADD CONSTRAINT bank_accounts_overdraft_limit_check CHECK (overdraft_limit >= 0);
+ALTER TABLE bank_accounts ADD CONSTRAINT bank_accounts_current_balance_check CHECK (current_balance >= -overdraft_limit);
@@ -0,0 +1,3 @@
+ALTER TABLE bank_accounts DROP CONSTRAINT IF EXISTS bank_accounts_jpy_whole_yen_check;
+ALTER TABLE bank_transactions DROP CONSTRAINT IF EXISTS bank_transactions_jpy_whole_yen_check;
+ALTER TABLE bank_transactions DROP COLUMN IF EXISTS currency;
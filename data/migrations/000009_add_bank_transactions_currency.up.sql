@@ -0,0 +1,11 @@
+ALTER TABLE bank_transactions ADD COLUMN IF NOT EXISTS currency VARCHAR(5);
+UPDATE bank_transactions t SET currency = a.currency FROM bank_accounts a WHERE t.account_uuid = a.account_uuid;
+
+-- JPY has no minor unit but the seed data stored yen amounts with cents. They are rounded to whole yen, half away from zero,
+-- before the ledger copies the balances so every stored amount converts to money.
+UPDATE bank_accounts SET current_balance = round(current_balance) WHERE currency = 'JPY' AND current_balance <> round(current_balance);
+UPDATE bank_transactions SET amount = round(amount) WHERE currency = 'JPY' AND amount <> round(amount);
+ALTER TABLE bank_accounts ADD CONSTRAINT bank_accounts_jpy_whole_yen_check
+    CHECK (currency <> 'JPY' OR (current_balance = round(current_balance) AND overdraft_limit = round(overdraft_limit)));
+ALTER TABLE bank_transactions ADD CONSTRAINT bank_transactions_jpy_whole_yen_check
+    CHECK (currency IS DISTINCT FROM 'JPY' OR amount = round(amount));
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys(
+    operation VARCHAR(50) NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    request_hash CHAR(64) NOT NULL,
+    resource_uuid UUID,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (operation, idempotency_key)
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS bank_transactions_timestamp_idx;
+DROP INDEX IF EXISTS bank_transactions_account_timestamp_idx;
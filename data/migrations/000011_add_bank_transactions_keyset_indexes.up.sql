@@ -0,0 +1,5 @@
+CREATE INDEX IF NOT EXISTS bank_transactions_account_timestamp_idx
+    ON bank_transactions (account_uuid, transaction_timestamp DESC, transaction_uuid DESC);
+
+CREATE INDEX IF NOT EXISTS bank_transactions_timestamp_idx
+    ON bank_transactions (transaction_timestamp DESC, transaction_uuid DESC);
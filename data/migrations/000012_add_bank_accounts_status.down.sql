@@ -0,0 +1,16 @@
+ALTER TABLE bank_transfers DROP CONSTRAINT IF EXISTS bank_transfers_to_account_uuid_fkey;
+ALTER TABLE bank_transfers ADD CONSTRAINT bank_transfers_to_account_uuid_fkey
+    FOREIGN KEY (to_account_uuid) REFERENCES bank_accounts (account_uuid) ON DELETE SET NULL;
+ALTER TABLE bank_transfers DROP CONSTRAINT IF EXISTS bank_transfers_from_account_uuid_fkey;
+ALTER TABLE bank_transfers ADD CONSTRAINT bank_transfers_from_account_uuid_fkey
+    FOREIGN KEY (from_account_uuid) REFERENCES bank_accounts (account_uuid) ON DELETE SET NULL;
+ALTER TABLE bank_transactions DROP CONSTRAINT IF EXISTS bank_transactions_account_uuid_fkey;
+ALTER TABLE bank_transactions ADD CONSTRAINT bank_transactions_account_uuid_fkey
+    FOREIGN KEY (account_uuid) REFERENCES bank_accounts (account_uuid) ON DELETE SET NULL;
+
+DROP INDEX IF EXISTS bank_accounts_created_at_idx;
+
+ALTER TABLE bank_accounts DROP CONSTRAINT IF EXISTS bank_accounts_closed_balance_check;
+ALTER TABLE bank_accounts DROP CONSTRAINT IF EXISTS bank_accounts_status_check;
+ALTER TABLE bank_accounts DROP COLUMN IF EXISTS closed_at;
+ALTER TABLE bank_accounts DROP COLUMN IF EXISTS status;
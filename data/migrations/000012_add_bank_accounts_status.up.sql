@@ -0,0 +1,17 @@
+ALTER TABLE bank_accounts ADD COLUMN IF NOT EXISTS status VARCHAR(10) NOT NULL DEFAULT 'active';
+ALTER TABLE bank_accounts ADD COLUMN IF NOT EXISTS closed_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE bank_accounts ADD CONSTRAINT bank_accounts_status_check CHECK (status IN ('active', 'frozen', 'closed'));
+ALTER TABLE bank_accounts ADD CONSTRAINT bank_accounts_closed_balance_check CHECK (status <> 'closed' OR current_balance = 0);
+
+CREATE INDEX IF NOT EXISTS bank_accounts_created_at_idx ON bank_accounts (created_at DESC, account_uuid DESC);
+
+-- accounts are soft deleted by closing them, the history must never lose its account reference
+ALTER TABLE bank_transactions DROP CONSTRAINT IF EXISTS bank_transactions_account_uuid_fkey;
+ALTER TABLE bank_transactions ADD CONSTRAINT bank_transactions_account_uuid_fkey
+    FOREIGN KEY (account_uuid) REFERENCES bank_accounts (account_uuid) ON DELETE RESTRICT;
+ALTER TABLE bank_transfers DROP CONSTRAINT IF EXISTS bank_transfers_from_account_uuid_fkey;
+ALTER TABLE bank_transfers ADD CONSTRAINT bank_transfers_from_account_uuid_fkey
+    FOREIGN KEY (from_account_uuid) REFERENCES bank_accounts (account_uuid) ON DELETE RESTRICT;
+ALTER TABLE bank_transfers DROP CONSTRAINT IF EXISTS bank_transfers_to_account_uuid_fkey;
+ALTER TABLE bank_transfers ADD CONSTRAINT bank_transfers_to_account_uuid_fkey
+    FOREIGN KEY (to_account_uuid) REFERENCES bank_accounts (account_uuid) ON DELETE RESTRICT;
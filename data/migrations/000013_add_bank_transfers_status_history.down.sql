@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS bank_transfer_status_history;
+
+DROP INDEX IF EXISTS bank_transfers_to_account_idx;
+DROP INDEX IF EXISTS bank_transfers_from_account_idx;
+DROP INDEX IF EXISTS bank_transfers_timestamp_idx;
+
+ALTER TABLE bank_transfers DROP CONSTRAINT IF EXISTS bank_transfers_status_check;
+ALTER TABLE bank_transfers ADD COLUMN IF NOT EXISTS transfer_succeed BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE bank_transfers SET transfer_succeed = (status = 'completed');
+ALTER TABLE bank_transfers ALTER COLUMN transfer_succeed DROP DEFAULT;
+
+ALTER TABLE bank_transfers DROP COLUMN IF EXISTS debit_amount;
+ALTER TABLE bank_transfers DROP COLUMN IF EXISTS debit_currency;
+ALTER TABLE bank_transfers DROP COLUMN IF EXISTS failure_reason;
+ALTER TABLE bank_transfers DROP COLUMN IF EXISTS status;
@@ -0,0 +1,28 @@
+ALTER TABLE bank_transfers ADD COLUMN IF NOT EXISTS status VARCHAR(10) NOT NULL DEFAULT 'pending';
+ALTER TABLE bank_transfers ADD COLUMN IF NOT EXISTS failure_reason TEXT;
+ALTER TABLE bank_transfers ADD COLUMN IF NOT EXISTS debit_currency VARCHAR(20);
+ALTER TABLE bank_transfers ADD COLUMN IF NOT EXISTS debit_amount NUMERIC(15,2);
+
+UPDATE bank_transfers SET status = CASE WHEN transfer_succeed THEN 'completed' ELSE 'failed' END;
+ALTER TABLE bank_transfers DROP COLUMN IF EXISTS transfer_succeed;
+ALTER TABLE bank_transfers ADD CONSTRAINT bank_transfers_status_check
+    CHECK (status IN ('pending', 'debited', 'completed', 'failed', 'reversed'));
+
+CREATE INDEX IF NOT EXISTS bank_transfers_timestamp_idx ON bank_transfers (transfer_timestamp DESC, transfer_uuid DESC);
+CREATE INDEX IF NOT EXISTS bank_transfers_from_account_idx ON bank_transfers (from_account_uuid, transfer_timestamp DESC);
+CREATE INDEX IF NOT EXISTS bank_transfers_to_account_idx ON bank_transfers (to_account_uuid, transfer_timestamp DESC);
+
+CREATE TABLE IF NOT EXISTS bank_transfer_status_history(
+    history_uuid UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    transfer_uuid UUID NOT NULL REFERENCES bank_transfers (transfer_uuid) ON DELETE CASCADE,
+    from_status VARCHAR(10),
+    to_status VARCHAR(10) NOT NULL,
+    reason TEXT,
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS bank_transfer_status_history_transfer_idx ON bank_transfer_status_history (transfer_uuid, changed_at);
+
+-- transfers created before the state machine only know their final outcome
+INSERT INTO bank_transfer_status_history (transfer_uuid, from_status, to_status, changed_at)
+SELECT transfer_uuid, NULL, status, updated_at FROM bank_transfers;
@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS ledger_postings_balanced_trigger ON ledger_postings;
+DROP FUNCTION IF EXISTS ledger_check_entry_balanced();
+DROP TABLE IF EXISTS ledger_postings;
+DROP TABLE IF EXISTS ledger_entries;
+DROP TABLE IF EXISTS ledger_system_accounts;
+
+ALTER TABLE bank_transactions DROP CONSTRAINT IF EXISTS bank_transactions_type_check;
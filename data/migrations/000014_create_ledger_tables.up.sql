@@ -0,0 +1,80 @@
+-- the seed data used a transaction type the application doesn't know
+UPDATE bank_transactions SET transaction_type = 'Withdraw' WHERE transaction_type = 'Withdrawal';
+ALTER TABLE bank_transactions ADD CONSTRAINT bank_transactions_type_check
+    CHECK (transaction_type IN ('Refund', 'Payment', 'Transfer', 'Deposit', 'Withdraw'));
+
+CREATE TABLE IF NOT EXISTS ledger_system_accounts(
+    code VARCHAR(30) NOT NULL PRIMARY KEY,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('external', 'fx', 'fees')),
+    currency VARCHAR(5) NOT NULL,
+    description TEXT NOT NULL
+);
+
+INSERT INTO ledger_system_accounts (code, kind, currency, description)
+SELECT k.kind || ':' || c.currency, k.kind, c.currency, k.description || ' in ' || c.currency
+FROM (VALUES
+    ('external', 'money entering or leaving the bank'),
+    ('fx', 'currency position taken by cross currency transfers'),
+    ('fees', 'fees and rounding collected by the bank')
+) AS k(kind, description)
+CROSS JOIN (VALUES ('USD'), ('EUR'), ('CAD'), ('GBP'), ('JPY')) AS c(currency);
+
+CREATE TABLE IF NOT EXISTS ledger_entries(
+    entry_uuid UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    entry_type VARCHAR(25) NOT NULL,
+    reference_uuid UUID,
+    description TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS ledger_entries_reference_idx ON ledger_entries (reference_uuid);
+
+CREATE TABLE IF NOT EXISTS ledger_postings(
+    posting_uuid UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    entry_uuid UUID NOT NULL REFERENCES ledger_entries (entry_uuid) ON DELETE RESTRICT,
+    account_uuid UUID REFERENCES bank_accounts (account_uuid) ON DELETE RESTRICT,
+    system_account VARCHAR(30) REFERENCES ledger_system_accounts (code) ON DELETE RESTRICT,
+    currency VARCHAR(5) NOT NULL,
+    amount NUMERIC(15,2) NOT NULL CHECK (amount <> 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT ledger_postings_single_account_check CHECK ((account_uuid IS NULL) <> (system_account IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS ledger_postings_entry_idx ON ledger_postings (entry_uuid);
+CREATE INDEX IF NOT EXISTS ledger_postings_account_idx ON ledger_postings (account_uuid) WHERE account_uuid IS NOT NULL;
+
+-- the postings of a journal entry must sum up to zero in every currency once the database transaction commits
+CREATE OR REPLACE FUNCTION ledger_check_entry_balanced() RETURNS trigger AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM ledger_postings
+        WHERE entry_uuid = NEW.entry_uuid
+        GROUP BY currency
+        HAVING SUM(amount) <> 0
+    ) THEN
+        RAISE EXCEPTION 'journal entry % is not balanced', NEW.entry_uuid;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER ledger_postings_balanced_trigger
+    AFTER INSERT OR UPDATE ON ledger_postings
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION ledger_check_entry_balanced();
+
+-- existing balances become opening balance entries funded from outside the bank
+INSERT INTO ledger_entries (entry_type, reference_uuid, description)
+SELECT 'opening_balance', account_uuid, 'opening balance of account ' || account_number
+FROM bank_accounts
+WHERE current_balance <> 0;
+
+INSERT INTO ledger_postings (entry_uuid, account_uuid, currency, amount)
+SELECT e.entry_uuid, a.account_uuid, a.currency, a.current_balance
+FROM ledger_entries e JOIN bank_accounts a ON a.account_uuid = e.reference_uuid
+WHERE e.entry_type = 'opening_balance';
+
+INSERT INTO ledger_postings (entry_uuid, system_account, currency, amount)
+SELECT e.entry_uuid, 'external:' || a.currency, a.currency, -a.current_balance
+FROM ledger_entries e JOIN bank_accounts a ON a.account_uuid = e.reference_uuid
+WHERE e.entry_type = 'opening_balance';
@@ -0,0 +1,4 @@
+-- subject of the authenticated customer owning the account, accounts without an owner are only reachable by the bank staff
+ALTER TABLE bank_accounts ADD COLUMN IF NOT EXISTS owner_subject VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS bank_accounts_owner_subject_idx ON bank_accounts (owner_subject, created_at DESC, account_uuid DESC);
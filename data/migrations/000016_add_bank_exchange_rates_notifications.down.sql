@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS bank_exchange_rates_notify_trigger ON bank_exchange_rates;
+DROP TRIGGER IF EXISTS bank_exchange_rates_sequence_trigger ON bank_exchange_rates;
+DROP FUNCTION IF EXISTS bank_exchange_rates_notify();
+DROP FUNCTION IF EXISTS bank_exchange_rates_sequence();
+
+ALTER TABLE bank_exchange_rates DROP COLUMN IF EXISTS sequence;
@@ -0,0 +1,41 @@
+-- sequence of the pair's rate, bumped every time the rate or its validity window changes
+ALTER TABLE bank_exchange_rates ADD COLUMN IF NOT EXISTS sequence BIGINT NOT NULL DEFAULT 1;
+
+CREATE OR REPLACE FUNCTION bank_exchange_rates_sequence() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        NEW.sequence := 1;
+    ELSIF (NEW.rate, NEW.valid_from_timestamp, NEW.valid_to_timestamp) IS DISTINCT FROM (OLD.rate, OLD.valid_from_timestamp, OLD.valid_to_timestamp) THEN
+        NEW.sequence := OLD.sequence + 1;
+    ELSE
+        NEW.sequence := OLD.sequence;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- every replica listens on bank_exchange_rate_changed, the notification is delivered when the writing transaction commits
+CREATE OR REPLACE FUNCTION bank_exchange_rates_notify() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' OR NEW.sequence <> OLD.sequence THEN
+        PERFORM pg_notify('bank_exchange_rate_changed', json_build_object(
+            'exchange_rate_uuid', NEW.exchange_rate_uuid,
+            'from_currency', NEW.from_currency,
+            'to_currency', NEW.to_currency,
+            'rate', NEW.rate::text,
+            'valid_from_timestamp', NEW.valid_from_timestamp,
+            'valid_to_timestamp', NEW.valid_to_timestamp,
+            'sequence', NEW.sequence
+        )::text);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER bank_exchange_rates_sequence_trigger
+    BEFORE INSERT OR UPDATE ON bank_exchange_rates
+    FOR EACH ROW EXECUTE FUNCTION bank_exchange_rates_sequence();
+
+CREATE TRIGGER bank_exchange_rates_notify_trigger
+    AFTER INSERT OR UPDATE ON bank_exchange_rates
+    FOR EACH ROW EXECUTE FUNCTION bank_exchange_rates_notify();
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS bank_exchange_rates_history_trigger ON bank_exchange_rates;
+DROP FUNCTION IF EXISTS bank_exchange_rates_history();
+
+DROP TABLE IF EXISTS bank_exchange_rate_history;
+DROP FUNCTION IF EXISTS bank_exchange_rate_history_append_only();
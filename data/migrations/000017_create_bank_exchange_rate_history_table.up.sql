@@ -0,0 +1,43 @@
+-- every rate a pair ever had, appended by the bank_exchange_rates trigger whenever the sequence of the pair moves
+CREATE TABLE IF NOT EXISTS bank_exchange_rate_history(
+    history_uuid UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_rate_uuid UUID NOT NULL REFERENCES bank_exchange_rates (exchange_rate_uuid),
+    from_currency VARCHAR(5) NOT NULL,
+    to_currency VARCHAR(5) NOT NULL,
+    rate numeric(20,10) NOT NULL,
+    valid_from_timestamp TIMESTAMP WITH TIME ZONE NOT NULL,
+    valid_to_timestamp TIMESTAMP WITH TIME ZONE NOT NULL,
+    sequence BIGINT NOT NULL,
+    recorded_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (exchange_rate_uuid, sequence)
+);
+
+CREATE INDEX IF NOT EXISTS bank_exchange_rate_history_pair_idx ON bank_exchange_rate_history (from_currency, to_currency, valid_from_timestamp DESC, sequence DESC);
+
+CREATE OR REPLACE FUNCTION bank_exchange_rates_history() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' OR NEW.sequence <> OLD.sequence THEN
+        INSERT INTO bank_exchange_rate_history (exchange_rate_uuid, from_currency, to_currency, rate, valid_from_timestamp, valid_to_timestamp, sequence)
+        VALUES (NEW.exchange_rate_uuid, NEW.from_currency, NEW.to_currency, NEW.rate, NEW.valid_from_timestamp, NEW.valid_to_timestamp, NEW.sequence);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER bank_exchange_rates_history_trigger
+    AFTER INSERT OR UPDATE ON bank_exchange_rates
+    FOR EACH ROW EXECUTE FUNCTION bank_exchange_rates_history();
+
+-- the history is append-only, past conversions must stay auditable
+CREATE OR REPLACE FUNCTION bank_exchange_rate_history_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'bank_exchange_rate_history is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER bank_exchange_rate_history_append_only_trigger
+    BEFORE UPDATE OR DELETE ON bank_exchange_rate_history
+    FOR EACH ROW EXECUTE FUNCTION bank_exchange_rate_history_append_only();
+
+INSERT INTO bank_exchange_rate_history (exchange_rate_uuid, from_currency, to_currency, rate, valid_from_timestamp, valid_to_timestamp, sequence)
+SELECT exchange_rate_uuid, from_currency, to_currency, rate, valid_from_timestamp, valid_to_timestamp, sequence FROM bank_exchange_rates;
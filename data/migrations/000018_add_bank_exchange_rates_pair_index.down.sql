@@ -0,0 +1 @@
+DROP INDEX IF EXISTS bank_exchange_rates_pair_idx;
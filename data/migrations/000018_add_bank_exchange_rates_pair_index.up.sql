@@ -0,0 +1,2 @@
+-- a pair has a single current rate, the rate providers upsert it
+CREATE UNIQUE INDEX IF NOT EXISTS bank_exchange_rates_pair_idx ON bank_exchange_rates (from_currency, to_currency);
@@ -0,0 +1 @@
+ALTER TABLE bank_exchange_rates DROP CONSTRAINT IF EXISTS bank_exchange_rates_single_direction;
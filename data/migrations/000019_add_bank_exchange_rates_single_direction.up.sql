@@ -0,0 +1,10 @@
+-- the rate of two currencies is stored once, from the alphabetically first currency to the second one, the opposite direction is its inverse.
+-- the pairs only stored in the opposite direction get their row in the stored direction
+INSERT INTO bank_exchange_rates (from_currency, to_currency, rate, valid_from_timestamp, valid_to_timestamp, updated_at)
+SELECT to_currency, from_currency, round(1 / rate, 10), valid_from_timestamp, valid_to_timestamp, NOW()
+FROM bank_exchange_rates
+WHERE from_currency > to_currency
+ON CONFLICT (from_currency, to_currency) DO NOTHING;
+
+-- the rows of the opposite direction stay for their history but can't be written anymore
+ALTER TABLE bank_exchange_rates ADD CONSTRAINT bank_exchange_rates_single_direction CHECK (from_currency < to_currency) NOT VALID;
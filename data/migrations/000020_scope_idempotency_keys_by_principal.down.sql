@@ -0,0 +1,6 @@
+-- the keys sent by several principals keep a single row
+DELETE FROM idempotency_keys k USING idempotency_keys other
+WHERE k.operation = other.operation AND k.idempotency_key = other.idempotency_key AND k.principal > other.principal;
+ALTER TABLE idempotency_keys DROP CONSTRAINT IF EXISTS idempotency_keys_pkey;
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS principal;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (operation, idempotency_key);
@@ -0,0 +1,4 @@
+-- an idempotency key belongs to the principal which sent it, the keys stored so far belong to no principal
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS principal VARCHAR(300) NOT NULL DEFAULT '';
+ALTER TABLE idempotency_keys DROP CONSTRAINT IF EXISTS idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (principal, operation, idempotency_key);
@@ -2,6 +2,8 @@ package data
 
 import (
 	"context"
+	"fmt"
+	"math/big"
 	"math/rand"
 	"time"
 
@@ -9,20 +11,31 @@ import (
 	"github.com/rs/zerolog"
 )
 
+const (
+	demoSamplingPeriod = 30 * time.Second
+	demoMaxStep        = 0.005 // largest relative change of a rate between two samples
+	demoMaxDeviation   = 0.10  // largest relative distance of a rate from its value when the sampler started
+)
+
+// DynamicExchangeRate is a dummy data sampler moving the stored rates by a small random step every sampling period, for demos only.
+// Each step multiplies the rate by a factor close to one and the rates stay within a band around their starting values,
+// so they never drift far away or below zero.
 type DynamicExchangeRate struct {
-	ad     *adapters.BankExchangeRateRepository
-	logger *zerolog.Logger
+	ad      *adapters.BankExchangeRateRepository
+	logger  *zerolog.Logger
+	anchors map[string]*big.Rat
 }
 
 func NewDynamicExchangeRate(ad *adapters.BankExchangeRateRepository, logger *zerolog.Logger) *DynamicExchangeRate {
 	return &DynamicExchangeRate{
-		ad:     ad,
-		logger: logger,
+		ad:      ad,
+		logger:  logger,
+		anchors: make(map[string]*big.Rat),
 	}
 }
 
 func (d *DynamicExchangeRate) ChangeExchangeRates(ctx context.Context) error {
-	d.logger.Info().Msg("started dynamic exchange rate sampler...")
+	d.logger.Info().Msg("started demo exchange rate sampler...")
 	for {
 		exRates, err := d.ad.GetAll(ctx)
 		if err != nil {
@@ -30,11 +43,34 @@ func (d *DynamicExchangeRate) ChangeExchangeRates(ctx context.Context) error {
 		}
 
 		for _, exRate := range exRates {
-			fluc := -2 + rand.Float64()*4
-			exRate.Rate += fluc
+			// rates are kept as exact decimals, only the random step comes from a float
+			rate, ok := new(big.Rat).SetString(exRate.Rate)
+			if !ok || rate.Sign() <= 0 {
+				return fmt.Errorf("invalid exchange rate %q for %s/%s", exRate.Rate, exRate.FromCurrency, exRate.ToCurrency)
+			}
+			pair := exRate.FromCurrency + "/" + exRate.ToCurrency
+			anchor, exists := d.anchors[pair]
+			if !exists {
+				anchor = new(big.Rat).Set(rate)
+				d.anchors[pair] = anchor
+			}
+
+			factor := new(big.Rat).SetFloat64(1 + demoMaxStep*(2*rand.Float64()-1))
+			rate.Mul(rate, factor)
+			lower := new(big.Rat).Mul(anchor, new(big.Rat).SetFloat64(1-demoMaxDeviation))
+			upper := new(big.Rat).Mul(anchor, new(big.Rat).SetFloat64(1+demoMaxDeviation))
+			if rate.Cmp(lower) < 0 {
+				rate = lower
+			}
+			if rate.Cmp(upper) > 0 {
+				rate = upper
+			}
+
+			exRate.Rate = rate.FloatString(10)
 			startTime := time.Now()
 			exRate.ValidFromTimestamp = startTime
-			exRate.ValidToTimestamp = startTime.Add(time.Second * 30)
+			// the rate stays valid for two sampling periods so it never goes stale between two samples
+			exRate.ValidToTimestamp = startTime.Add(2 * demoSamplingPeriod)
 			_, err := d.ad.Update(ctx, exRate.ExchangeRateUUID, &exRate)
 			if err != nil {
 				return err
@@ -43,9 +79,15 @@ func (d *DynamicExchangeRate) ChangeExchangeRates(ctx context.Context) error {
 				Str("exchange_rate_uuid", exRate.ExchangeRateUUID.String()).
 				Str("from_currency", exRate.FromCurrency).
 				Str("to_currency", exRate.ToCurrency).
-				Float64("new_rate", exRate.Rate).
-				Time("rate_validity_period", exRate.ValidToTimestamp)
+				Str("new_rate", exRate.Rate).
+				Time("rate_validity_period", exRate.ValidToTimestamp).
+				Msg("sampled demo exchange rate")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(demoSamplingPeriod):
 		}
-		time.Sleep(30 * time.Second)
 	}
 }
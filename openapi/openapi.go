@@ -0,0 +1,7 @@
+// Package openapi holds the OpenAPI document of the http gateway, generated from the google.api.http annotations of proto/bank/service.proto
+package openapi
+
+import _ "embed"
+
+//go:embed bank.swagger.json
+var BankServiceSpec []byte
@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,11 +22,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type RateInterval int32
+
+const (
+	RateInterval_RateInterval_UNSPECIFIED RateInterval = 0
+	RateInterval_Minute                   RateInterval = 1
+	RateInterval_Hour                     RateInterval = 2
+	RateInterval_Day                      RateInterval = 3
+)
+
+// Enum value maps for RateInterval.
+var (
+	RateInterval_name = map[int32]string{
+		0: "RateInterval_UNSPECIFIED",
+		1: "Minute",
+		2: "Hour",
+		3: "Day",
+	}
+	RateInterval_value = map[string]int32{
+		"RateInterval_UNSPECIFIED": 0,
+		"Minute":                   1,
+		"Hour":                     2,
+		"Day":                      3,
+	}
+)
+
+func (x RateInterval) Enum() *RateInterval {
+	p := new(RateInterval)
+	*p = x
+	return p
+}
+
+func (x RateInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RateInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_bank_type_exchangeRates_proto_enumTypes[0].Descriptor()
+}
+
+func (RateInterval) Type() protoreflect.EnumType {
+	return &file_proto_bank_type_exchangeRates_proto_enumTypes[0]
+}
+
+func (x RateInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RateInterval.Descriptor instead.
+func (RateInterval) EnumDescriptor() ([]byte, []int) {
+	return file_proto_bank_type_exchangeRates_proto_rawDescGZIP(), []int{0}
+}
+
 type ExchangeRateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromCurrency  Currency               `protobuf:"varint,1,opt,name=FromCurrency,json=from_currency,proto3,enum=bank.Currency" json:"FromCurrency,omitempty"`
 	ToCurrency    Currency               `protobuf:"varint,2,opt,name=ToCurrency,json=to_currency,proto3,enum=bank.Currency" json:"ToCurrency,omitempty"`
-	Amount        float64                `protobuf:"fixed64,3,opt,name=Amount,json=amout,proto3" json:"Amount,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,4,opt,name=Amount,json=amount,proto3" json:"Amount,omitempty"` // amount in the source currency
+	RoundingMode  RoundingMode           `protobuf:"varint,5,opt,name=RoundingMode,json=rounding_mode,proto3,enum=bank.RoundingMode" json:"RoundingMode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -60,31 +113,36 @@ func (*ExchangeRateRequest) Descriptor() ([]byte, []int) {
 	return file_proto_bank_type_exchangeRates_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *ExchangeRateRequest) GetFromCurrency() Currency {
+func (x *ExchangeRateRequest) GetToCurrency() Currency {
 	if x != nil {
-		return x.FromCurrency
+		return x.ToCurrency
 	}
 	return Currency_Currency_UNSPECEFIED
 }
 
-func (x *ExchangeRateRequest) GetToCurrency() Currency {
+func (x *ExchangeRateRequest) GetAmount() *Money {
 	if x != nil {
-		return x.ToCurrency
+		return x.Amount
 	}
-	return Currency_Currency_UNSPECEFIED
+	return nil
 }
 
-func (x *ExchangeRateRequest) GetAmount() float64 {
+func (x *ExchangeRateRequest) GetRoundingMode() RoundingMode {
 	if x != nil {
-		return x.Amount
+		return x.RoundingMode
 	}
-	return 0
+	return RoundingMode_RoundingMode_UNSPECIFIED
 }
 
+// ExchangeRateResponse is sent when the stream starts and then every time the rate of the pair changes
 type ExchangeRateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Currency      string                 `protobuf:"bytes,1,opt,name=Currency,json=currency,proto3" json:"Currency,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=Amount,json=amount,proto3" json:"Amount,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,3,opt,name=Amount,json=amount,proto3" json:"Amount,omitempty"` // amount converted with the rate
+	Rate          string                 `protobuf:"bytes,4,opt,name=Rate,json=rate,proto3" json:"Rate,omitempty"`       // decimal rate from the source to the target currency, an inverse or cross rate is rounded to 10 decimal digits
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ValidFrom,json=valid_from,proto3" json:"ValidFrom,omitempty"`
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ValidTo,json=valid_to,proto3" json:"ValidTo,omitempty"`
+	Sequence      uint64                 `protobuf:"varint,7,opt,name=Sequence,json=sequence,proto3" json:"Sequence,omitempty"` // grows with every change of the pair's rate, a gap means the intermediate rates were skipped
+	Path          []*RateLeg             `protobuf:"bytes,8,rep,name=Path,json=path,proto3" json:"Path,omitempty"`              // stored rates the rate was derived from
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -119,44 +177,818 @@ func (*ExchangeRateResponse) Descriptor() ([]byte, []int) {
 	return file_proto_bank_type_exchangeRates_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ExchangeRateResponse) GetCurrency() string {
+func (x *ExchangeRateResponse) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ExchangeRateResponse) GetRate() string {
 	if x != nil {
-		return x.Currency
+		return x.Rate
 	}
 	return ""
 }
 
-func (x *ExchangeRateResponse) GetAmount() float64 {
+func (x *ExchangeRateResponse) GetValidFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Amount
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *ExchangeRateResponse) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *ExchangeRateResponse) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ExchangeRateResponse) GetPath() []*RateLeg {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+type CurrencyPair struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          Currency               `protobuf:"varint,1,opt,name=From,json=from,proto3,enum=bank.Currency" json:"From,omitempty"`
+	To            Currency               `protobuf:"varint,2,opt,name=To,json=to,proto3,enum=bank.Currency" json:"To,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyPair) Reset() {
+	*x = CurrencyPair{}
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyPair) ProtoMessage() {}
+
+func (x *CurrencyPair) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyPair.ProtoReflect.Descriptor instead.
+func (*CurrencyPair) Descriptor() ([]byte, []int) {
+	return file_proto_bank_type_exchangeRates_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CurrencyPair) GetFrom() Currency {
+	if x != nil {
+		return x.From
+	}
+	return Currency_Currency_UNSPECEFIED
+}
+
+func (x *CurrencyPair) GetTo() Currency {
+	if x != nil {
+		return x.To
+	}
+	return Currency_Currency_UNSPECEFIED
+}
+
+// ExchangeRateSubscriptionRequest changes the set of pairs watched by a SubscribeExchangeRates stream.
+// A base subscribes to the pairs converting from that currency to every other supported currency, the pairs without a rate yet are sent once they have one.
+type ExchangeRateSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddPairs      []*CurrencyPair        `protobuf:"bytes,1,rep,name=AddPairs,json=add_pairs,proto3" json:"AddPairs,omitempty"`
+	RemovePairs   []*CurrencyPair        `protobuf:"bytes,2,rep,name=RemovePairs,json=remove_pairs,proto3" json:"RemovePairs,omitempty"`
+	AddBases      []Currency             `protobuf:"varint,3,rep,packed,name=AddBases,json=add_bases,proto3,enum=bank.Currency" json:"AddBases,omitempty"`
+	RemoveBases   []Currency             `protobuf:"varint,4,rep,packed,name=RemoveBases,json=remove_bases,proto3,enum=bank.Currency" json:"RemoveBases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRateSubscriptionRequest) Reset() {
+	*x = ExchangeRateSubscriptionRequest{}
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRateSubscriptionRequest) ProtoMessage() {}
+
+func (x *ExchangeRateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ExchangeRateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bank_type_exchangeRates_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExchangeRateSubscriptionRequest) GetAddPairs() []*CurrencyPair {
+	if x != nil {
+		return x.AddPairs
+	}
+	return nil
+}
+
+func (x *ExchangeRateSubscriptionRequest) GetRemovePairs() []*CurrencyPair {
+	if x != nil {
+		return x.RemovePairs
+	}
+	return nil
+}
+
+func (x *ExchangeRateSubscriptionRequest) GetAddBases() []Currency {
+	if x != nil {
+		return x.AddBases
+	}
+	return nil
+}
+
+func (x *ExchangeRateSubscriptionRequest) GetRemoveBases() []Currency {
+	if x != nil {
+		return x.RemoveBases
+	}
+	return nil
+}
+
+type ExchangeRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pair          *CurrencyPair          `protobuf:"bytes,1,opt,name=Pair,json=pair,proto3" json:"Pair,omitempty"`
+	Rate          string                 `protobuf:"bytes,2,opt,name=Rate,json=rate,proto3" json:"Rate,omitempty"` // decimal rate from the source to the target currency, an inverse or cross rate is rounded to 10 decimal digits
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ValidFrom,json=valid_from,proto3" json:"ValidFrom,omitempty"`
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ValidTo,json=valid_to,proto3" json:"ValidTo,omitempty"`
+	Sequence      uint64                 `protobuf:"varint,5,opt,name=Sequence,json=sequence,proto3" json:"Sequence,omitempty"` // grows with every change of the pair's rate, a gap means the intermediate rates were skipped
+	Path          []*RateLeg             `protobuf:"bytes,6,rep,name=Path,json=path,proto3" json:"Path,omitempty"`              // stored rates the rate was derived from
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_proto_bank_type_exchangeRates_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExchangeRate) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *ExchangeRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *ExchangeRate) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *ExchangeRate) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetPath() []*RateLeg {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+// RateLeg is a stored rate used to derive the rate of a pair. The rate of two currencies is stored in a single direction:
+// the opposite direction uses an inverted leg and a pair without a stored rate is crossed through the pivot currency with two legs.
+type RateLeg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pair          *CurrencyPair          `protobuf:"bytes,1,opt,name=Pair,json=pair,proto3" json:"Pair,omitempty"`              // pair the rate is stored under
+	Rate          string                 `protobuf:"bytes,2,opt,name=Rate,json=rate,proto3" json:"Rate,omitempty"`              // stored rate from the From to the To currency of the pair
+	Inverted      bool                   `protobuf:"varint,3,opt,name=Inverted,json=inverted,proto3" json:"Inverted,omitempty"` // the leg converts from the To to the From currency with the inverse of the stored rate
+	Sequence      uint64                 `protobuf:"varint,4,opt,name=Sequence,json=sequence,proto3" json:"Sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLeg) Reset() {
+	*x = RateLeg{}
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLeg) ProtoMessage() {}
+
+func (x *RateLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLeg.ProtoReflect.Descriptor instead.
+func (*RateLeg) Descriptor() ([]byte, []int) {
+	return file_proto_bank_type_exchangeRates_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RateLeg) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *RateLeg) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *RateLeg) GetInverted() bool {
+	if x != nil {
+		return x.Inverted
+	}
+	return false
+}
+
+func (x *RateLeg) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
 	}
 	return 0
 }
 
+// ExchangeRateUpdates carries the current rates of the pairs added by a request, then the rates changed since the previous batch
+type ExchangeRateUpdates struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=Rates,json=rates,proto3" json:"Rates,omitempty"`
+	Unknown       []*CurrencyPair        `protobuf:"bytes,2,rep,name=Unknown,json=unknown,proto3" json:"Unknown,omitempty"` // added pairs without a rate yet, their first rate is sent as soon as it's written
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRateUpdates) Reset() {
+	*x = ExchangeRateUpdates{}
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRateUpdates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRateUpdates) ProtoMessage() {}
+
+func (x *ExchangeRateUpdates) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRateUpdates.ProtoReflect.Descriptor instead.
+func (*ExchangeRateUpdates) Descriptor() ([]byte, []int) {
+	return file_proto_bank_type_exchangeRates_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExchangeRateUpdates) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *ExchangeRateUpdates) GetUnknown() []*CurrencyPair {
+	if x != nil {
+		return x.Unknown
+	}
+	return nil
+}
+
+// ExchangeRateAsOfRequest looks up the rate a pair had at a point in time, e.g. to audit a past conversion
+type ExchangeRateAsOfRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromCurrency  Currency               `protobuf:"varint,1,opt,name=FromCurrency,json=from_currency,proto3,enum=bank.Currency" json:"FromCurrency,omitempty"`
+	ToCurrency    Currency               `protobuf:"varint,2,opt,name=ToCurrency,json=to_currency,proto3,enum=bank.Currency" json:"ToCurrency,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=AsOf,json=as_of,proto3" json:"AsOf,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRateAsOfRequest) Reset() {
+	*x = ExchangeRateAsOfRequest{}
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRateAsOfRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRateAsOfRequest) ProtoMessage() {}
+
+func (x *ExchangeRateAsOfRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRateAsOfRequest.ProtoReflect.Descriptor instead.
+func (*ExchangeRateAsOfRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bank_type_exchangeRates_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExchangeRateAsOfRequest) GetFromCurrency() Currency {
+	if x != nil {
+		return x.FromCurrency
+	}
+	return Currency_Currency_UNSPECEFIED
+}
+
+func (x *ExchangeRateAsOfRequest) GetToCurrency() Currency {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return Currency_Currency_UNSPECEFIED
+}
+
+func (x *ExchangeRateAsOfRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type ExchangeRateHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromCurrency  Currency               `protobuf:"varint,1,opt,name=FromCurrency,json=from_currency,proto3,enum=bank.Currency" json:"FromCurrency,omitempty"`
+	ToCurrency    Currency               `protobuf:"varint,2,opt,name=ToCurrency,json=to_currency,proto3,enum=bank.Currency" json:"ToCurrency,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=StartTime,json=start_time,proto3" json:"StartTime,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=EndTime,json=end_time,proto3" json:"EndTime,omitempty"` // exclusive
+	Interval      RateInterval           `protobuf:"varint,5,opt,name=Interval,json=interval,proto3,enum=bank.RateInterval" json:"Interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRateHistoryRequest) Reset() {
+	*x = ExchangeRateHistoryRequest{}
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRateHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRateHistoryRequest) ProtoMessage() {}
+
+func (x *ExchangeRateHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRateHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ExchangeRateHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bank_type_exchangeRates_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExchangeRateHistoryRequest) GetFromCurrency() Currency {
+	if x != nil {
+		return x.FromCurrency
+	}
+	return Currency_Currency_UNSPECEFIED
+}
+
+func (x *ExchangeRateHistoryRequest) GetToCurrency() Currency {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return Currency_Currency_UNSPECEFIED
+}
+
+func (x *ExchangeRateHistoryRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ExchangeRateHistoryRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ExchangeRateHistoryRequest) GetInterval() RateInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return RateInterval_RateInterval_UNSPECIFIED
+}
+
+// ExchangeRateCandle aggregates the rates in effect during an interval. Open is the rate in effect when the interval starts,
+// Close the one in effect when it ends and Changes the number of rate changes within the interval.
+type ExchangeRateCandle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=StartTime,json=start_time,proto3" json:"StartTime,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=EndTime,json=end_time,proto3" json:"EndTime,omitempty"`
+	Open          string                 `protobuf:"bytes,3,opt,name=Open,json=open,proto3" json:"Open,omitempty"`
+	High          string                 `protobuf:"bytes,4,opt,name=High,json=high,proto3" json:"High,omitempty"`
+	Low           string                 `protobuf:"bytes,5,opt,name=Low,json=low,proto3" json:"Low,omitempty"`
+	Close         string                 `protobuf:"bytes,6,opt,name=Close,json=close,proto3" json:"Close,omitempty"`
+	Changes       uint32                 `protobuf:"varint,7,opt,name=Changes,json=changes,proto3" json:"Changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRateCandle) Reset() {
+	*x = ExchangeRateCandle{}
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRateCandle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRateCandle) ProtoMessage() {}
+
+func (x *ExchangeRateCandle) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRateCandle.ProtoReflect.Descriptor instead.
+func (*ExchangeRateCandle) Descriptor() ([]byte, []int) {
+	return file_proto_bank_type_exchangeRates_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExchangeRateCandle) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ExchangeRateCandle) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ExchangeRateCandle) GetOpen() string {
+	if x != nil {
+		return x.Open
+	}
+	return ""
+}
+
+func (x *ExchangeRateCandle) GetHigh() string {
+	if x != nil {
+		return x.High
+	}
+	return ""
+}
+
+func (x *ExchangeRateCandle) GetLow() string {
+	if x != nil {
+		return x.Low
+	}
+	return ""
+}
+
+func (x *ExchangeRateCandle) GetClose() string {
+	if x != nil {
+		return x.Close
+	}
+	return ""
+}
+
+func (x *ExchangeRateCandle) GetChanges() uint32 {
+	if x != nil {
+		return x.Changes
+	}
+	return 0
+}
+
+// ExchangeRateHistoryResponse has a candle per interval, starting with the first interval the pair had a rate in
+type ExchangeRateHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pair          *CurrencyPair          `protobuf:"bytes,1,opt,name=Pair,json=pair,proto3" json:"Pair,omitempty"`
+	Candles       []*ExchangeRateCandle  `protobuf:"bytes,2,rep,name=Candles,json=candles,proto3" json:"Candles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRateHistoryResponse) Reset() {
+	*x = ExchangeRateHistoryResponse{}
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRateHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRateHistoryResponse) ProtoMessage() {}
+
+func (x *ExchangeRateHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bank_type_exchangeRates_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRateHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ExchangeRateHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bank_type_exchangeRates_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExchangeRateHistoryResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *ExchangeRateHistoryResponse) GetCandles() []*ExchangeRateCandle {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
 var File_proto_bank_type_exchangeRates_proto protoreflect.FileDescriptor
 
 var file_proto_bank_type_exchangeRates_proto_rawDesc = string([]byte{
 	0x0a, 0x23, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x74, 0x79, 0x70,
 	0x65, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x62, 0x61, 0x6e, 0x6b, 0x1a, 0x1e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x01, 0x0a, 0x13,
-	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0c, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x62, 0x61, 0x6e, 0x6b, 0x1a, 0x1b, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x6d, 0x6f, 0x6e,
+	0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcc, 0x01, 0x0a, 0x13, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x54, 0x6f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x08, 0x01, 0x30, 0x01, 0x52,
+	0x0b, 0x74, 0x6f, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2d, 0x0a, 0x06,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x62,
+	0x61, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x08,
+	0x01, 0x40, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x30, 0x01, 0x52, 0x0d, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x4a, 0x04, 0x08, 0x01,
+	0x10, 0x02, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x8c, 0x02, 0x0a, 0x14, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x23, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x35, 0x0a, 0x07, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x6f,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x52, 0x61,
+	0x74, 0x65, 0x4c, 0x65, 0x67, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x4a, 0x04, 0x08, 0x01, 0x10,
+	0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x66, 0x0a, 0x0c, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x2c, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x08, 0x01, 0x30, 0x01, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x28, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0e, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x08, 0x01, 0x30, 0x01, 0x52, 0x02, 0x74, 0x6f, 0x22,
+	0xe9, 0x01, 0x0a, 0x1f, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x50, 0x61, 0x69, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x09, 0x61, 0x64, 0x64, 0x5f, 0x70,
+	0x61, 0x69, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61,
+	0x69, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x61, 0x6e, 0x6b,
+	0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0c, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x41,
+	0x64, 0x64, 0x42, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
+	0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x09, 0x61,
+	0x64, 0x64, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x42, 0x61, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
+	0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x73, 0x22, 0xfb, 0x01, 0x0a, 0x0c,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x04,
+	0x50, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x61, 0x6e,
+	0x6b, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04,
+	0x70, 0x61, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x35, 0x0a, 0x07, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x52, 0x61, 0x74, 0x65,
+	0x4c, 0x65, 0x67, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x7d, 0x0a, 0x07, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x65, 0x67, 0x12, 0x26, 0x0a, 0x04, 0x50, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x52, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x6d, 0x0a, 0x13, 0x45, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x28, 0x0a, 0x05, 0x52, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x55, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x61, 0x6e,
+	0x6b, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x07,
+	0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x22, 0xcc, 0x01, 0x0a, 0x17, 0x45, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x41, 0x73, 0x4f, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x75, 0x72, 0x72, 0x65,
 	0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x62, 0x61, 0x6e, 0x6b,
-	0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2f, 0x0a, 0x0a, 0x54, 0x6f, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x62,
-	0x61, 0x6e, 0x6b, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0b, 0x74, 0x6f,
-	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x61, 0x6d, 0x6f, 0x75, 0x74,
-	0x22, 0x4a, 0x0a, 0x14, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0d, 0x5a, 0x0b,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x08,
+	0x01, 0x30, 0x01, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x54, 0x6f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x08, 0x01, 0x30, 0x01,
+	0x52, 0x0b, 0x74, 0x6f, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x37, 0x0a,
+	0x04, 0x41, 0x73, 0x4f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x52,
+	0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x22, 0xd2, 0x02, 0x0a, 0x1a, 0x45, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x62, 0x61,
+	0x6e, 0x6b, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x08, 0x8a, 0xb5, 0x18,
+	0x04, 0x08, 0x01, 0x30, 0x01, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x54, 0x6f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x08, 0x01,
+	0x30, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x41, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06,
+	0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x06, 0x8a, 0xb5, 0x18, 0x02, 0x08, 0x01, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x38, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x08, 0x01, 0x30,
+	0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0xf0, 0x01, 0x0a, 0x12,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a,
+	0x07, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4f, 0x70, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x69, 0x67, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x10, 0x0a, 0x03,
+	0x4c, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12, 0x14,
+	0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x79,
+	0x0a, 0x1b, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x04, 0x50, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x61,
+	0x6e, 0x6b, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52,
+	0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x52, 0x07, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x2a, 0x4b, 0x0a, 0x0c, 0x52, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x6f, 0x75, 0x72, 0x10, 0x02, 0x12, 0x07, 0x0a,
+	0x03, 0x44, 0x61, 0x79, 0x10, 0x03, 0x42, 0x0d, 0x5a, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67,
+	0x65, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -171,20 +1003,64 @@ func file_proto_bank_type_exchangeRates_proto_rawDescGZIP() []byte {
 	return file_proto_bank_type_exchangeRates_proto_rawDescData
 }
 
-var file_proto_bank_type_exchangeRates_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_bank_type_exchangeRates_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_bank_type_exchangeRates_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_proto_bank_type_exchangeRates_proto_goTypes = []any{
-	(*ExchangeRateRequest)(nil),  // 0: bank.ExchangeRateRequest
-	(*ExchangeRateResponse)(nil), // 1: bank.ExchangeRateResponse
-	(Currency)(0),                // 2: bank.Currency
+	(RateInterval)(0),                       // 0: bank.RateInterval
+	(*ExchangeRateRequest)(nil),             // 1: bank.ExchangeRateRequest
+	(*ExchangeRateResponse)(nil),            // 2: bank.ExchangeRateResponse
+	(*CurrencyPair)(nil),                    // 3: bank.CurrencyPair
+	(*ExchangeRateSubscriptionRequest)(nil), // 4: bank.ExchangeRateSubscriptionRequest
+	(*ExchangeRate)(nil),                    // 5: bank.ExchangeRate
+	(*RateLeg)(nil),                         // 6: bank.RateLeg
+	(*ExchangeRateUpdates)(nil),             // 7: bank.ExchangeRateUpdates
+	(*ExchangeRateAsOfRequest)(nil),         // 8: bank.ExchangeRateAsOfRequest
+	(*ExchangeRateHistoryRequest)(nil),      // 9: bank.ExchangeRateHistoryRequest
+	(*ExchangeRateCandle)(nil),              // 10: bank.ExchangeRateCandle
+	(*ExchangeRateHistoryResponse)(nil),     // 11: bank.ExchangeRateHistoryResponse
+	(Currency)(0),                           // 12: bank.Currency
+	(*Money)(nil),                           // 13: bank.Money
+	(RoundingMode)(0),                       // 14: bank.RoundingMode
+	(*timestamppb.Timestamp)(nil),           // 15: google.protobuf.Timestamp
 }
 var file_proto_bank_type_exchangeRates_proto_depIdxs = []int32{
-	2, // 0: bank.ExchangeRateRequest.FromCurrency:type_name -> bank.Currency
-	2, // 1: bank.ExchangeRateRequest.ToCurrency:type_name -> bank.Currency
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	12, // 0: bank.ExchangeRateRequest.ToCurrency:type_name -> bank.Currency
+	13, // 1: bank.ExchangeRateRequest.Amount:type_name -> bank.Money
+	14, // 2: bank.ExchangeRateRequest.RoundingMode:type_name -> bank.RoundingMode
+	13, // 3: bank.ExchangeRateResponse.Amount:type_name -> bank.Money
+	15, // 4: bank.ExchangeRateResponse.ValidFrom:type_name -> google.protobuf.Timestamp
+	15, // 5: bank.ExchangeRateResponse.ValidTo:type_name -> google.protobuf.Timestamp
+	6,  // 6: bank.ExchangeRateResponse.Path:type_name -> bank.RateLeg
+	12, // 7: bank.CurrencyPair.From:type_name -> bank.Currency
+	12, // 8: bank.CurrencyPair.To:type_name -> bank.Currency
+	3,  // 9: bank.ExchangeRateSubscriptionRequest.AddPairs:type_name -> bank.CurrencyPair
+	3,  // 10: bank.ExchangeRateSubscriptionRequest.RemovePairs:type_name -> bank.CurrencyPair
+	12, // 11: bank.ExchangeRateSubscriptionRequest.AddBases:type_name -> bank.Currency
+	12, // 12: bank.ExchangeRateSubscriptionRequest.RemoveBases:type_name -> bank.Currency
+	3,  // 13: bank.ExchangeRate.Pair:type_name -> bank.CurrencyPair
+	15, // 14: bank.ExchangeRate.ValidFrom:type_name -> google.protobuf.Timestamp
+	15, // 15: bank.ExchangeRate.ValidTo:type_name -> google.protobuf.Timestamp
+	6,  // 16: bank.ExchangeRate.Path:type_name -> bank.RateLeg
+	3,  // 17: bank.RateLeg.Pair:type_name -> bank.CurrencyPair
+	5,  // 18: bank.ExchangeRateUpdates.Rates:type_name -> bank.ExchangeRate
+	3,  // 19: bank.ExchangeRateUpdates.Unknown:type_name -> bank.CurrencyPair
+	12, // 20: bank.ExchangeRateAsOfRequest.FromCurrency:type_name -> bank.Currency
+	12, // 21: bank.ExchangeRateAsOfRequest.ToCurrency:type_name -> bank.Currency
+	15, // 22: bank.ExchangeRateAsOfRequest.AsOf:type_name -> google.protobuf.Timestamp
+	12, // 23: bank.ExchangeRateHistoryRequest.FromCurrency:type_name -> bank.Currency
+	12, // 24: bank.ExchangeRateHistoryRequest.ToCurrency:type_name -> bank.Currency
+	15, // 25: bank.ExchangeRateHistoryRequest.StartTime:type_name -> google.protobuf.Timestamp
+	15, // 26: bank.ExchangeRateHistoryRequest.EndTime:type_name -> google.protobuf.Timestamp
+	0,  // 27: bank.ExchangeRateHistoryRequest.Interval:type_name -> bank.RateInterval
+	15, // 28: bank.ExchangeRateCandle.StartTime:type_name -> google.protobuf.Timestamp
+	15, // 29: bank.ExchangeRateCandle.EndTime:type_name -> google.protobuf.Timestamp
+	3,  // 30: bank.ExchangeRateHistoryResponse.Pair:type_name -> bank.CurrencyPair
+	10, // 31: bank.ExchangeRateHistoryResponse.Candles:type_name -> bank.ExchangeRateCandle
+	32, // [32:32] is the sub-list for method output_type
+	32, // [32:32] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_proto_bank_type_exchangeRates_proto_init() }
@@ -192,19 +1068,21 @@ func file_proto_bank_type_exchangeRates_proto_init() {
 	if File_proto_bank_type_exchangeRates_proto != nil {
 		return
 	}
-	file_proto_bank_type_accounts_proto_init()
+	file_proto_bank_type_money_proto_init()
+	file_proto_bank_type_validate_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_bank_type_exchangeRates_proto_rawDesc), len(file_proto_bank_type_exchangeRates_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_proto_bank_type_exchangeRates_proto_goTypes,
 		DependencyIndexes: file_proto_bank_type_exchangeRates_proto_depIdxs,
+		EnumInfos:         file_proto_bank_type_exchangeRates_proto_enumTypes,
 		MessageInfos:      file_proto_bank_type_exchangeRates_proto_msgTypes,
 	}.Build()
 	File_proto_bank_type_exchangeRates_proto = out.File
@@ -0,0 +1,370 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: proto/bank/type/ledger.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VerifyLedgerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyLedgerRequest) Reset() {
+	*x = VerifyLedgerRequest{}
+	mi := &file_proto_bank_type_ledger_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyLedgerRequest) ProtoMessage() {}
+
+func (x *VerifyLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bank_type_ledger_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyLedgerRequest.ProtoReflect.Descriptor instead.
+func (*VerifyLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bank_type_ledger_proto_rawDescGZIP(), []int{0}
+}
+
+// LedgerBalanceMismatch is a bank account whose stored balance differs from the sum of its ledger postings
+type LedgerBalanceMismatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountUUID   string                 `protobuf:"bytes,1,opt,name=AccountUUID,json=account_uuid,proto3" json:"AccountUUID,omitempty"`
+	StoredBalance *Money                 `protobuf:"bytes,2,opt,name=StoredBalance,json=stored_balance,proto3" json:"StoredBalance,omitempty"`
+	LedgerBalance *Money                 `protobuf:"bytes,3,opt,name=LedgerBalance,json=ledger_balance,proto3" json:"LedgerBalance,omitempty"`
+	Difference    *Money                 `protobuf:"bytes,4,opt,name=Difference,json=difference,proto3" json:"Difference,omitempty"` // stored_balance - ledger_balance
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerBalanceMismatch) Reset() {
+	*x = LedgerBalanceMismatch{}
+	mi := &file_proto_bank_type_ledger_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerBalanceMismatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerBalanceMismatch) ProtoMessage() {}
+
+func (x *LedgerBalanceMismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bank_type_ledger_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerBalanceMismatch.ProtoReflect.Descriptor instead.
+func (*LedgerBalanceMismatch) Descriptor() ([]byte, []int) {
+	return file_proto_bank_type_ledger_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LedgerBalanceMismatch) GetAccountUUID() string {
+	if x != nil {
+		return x.AccountUUID
+	}
+	return ""
+}
+
+func (x *LedgerBalanceMismatch) GetStoredBalance() *Money {
+	if x != nil {
+		return x.StoredBalance
+	}
+	return nil
+}
+
+func (x *LedgerBalanceMismatch) GetLedgerBalance() *Money {
+	if x != nil {
+		return x.LedgerBalance
+	}
+	return nil
+}
+
+func (x *LedgerBalanceMismatch) GetDifference() *Money {
+	if x != nil {
+		return x.Difference
+	}
+	return nil
+}
+
+// UnbalancedJournalEntry is a journal entry whose postings don't sum up to zero in a currency
+type UnbalancedJournalEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryUUID     string                 `protobuf:"bytes,1,opt,name=EntryUUID,json=entry_uuid,proto3" json:"EntryUUID,omitempty"`
+	Imbalance     *Money                 `protobuf:"bytes,2,opt,name=Imbalance,json=imbalance,proto3" json:"Imbalance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbalancedJournalEntry) Reset() {
+	*x = UnbalancedJournalEntry{}
+	mi := &file_proto_bank_type_ledger_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbalancedJournalEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbalancedJournalEntry) ProtoMessage() {}
+
+func (x *UnbalancedJournalEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bank_type_ledger_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbalancedJournalEntry.ProtoReflect.Descriptor instead.
+func (*UnbalancedJournalEntry) Descriptor() ([]byte, []int) {
+	return file_proto_bank_type_ledger_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UnbalancedJournalEntry) GetEntryUUID() string {
+	if x != nil {
+		return x.EntryUUID
+	}
+	return ""
+}
+
+func (x *UnbalancedJournalEntry) GetImbalance() *Money {
+	if x != nil {
+		return x.Imbalance
+	}
+	return nil
+}
+
+type VerifyLedgerResponse struct {
+	state             protoimpl.MessageState    `protogen:"open.v1"`
+	Balanced          bool                      `protobuf:"varint,1,opt,name=Balanced,json=balanced,proto3" json:"Balanced,omitempty"` // true when no discrepancy was found
+	AccountsChecked   int64                     `protobuf:"varint,2,opt,name=AccountsChecked,json=accounts_checked,proto3" json:"AccountsChecked,omitempty"`
+	Mismatches        []*LedgerBalanceMismatch  `protobuf:"bytes,3,rep,name=Mismatches,json=mismatches,proto3" json:"Mismatches,omitempty"`
+	UnbalancedEntries []*UnbalancedJournalEntry `protobuf:"bytes,4,rep,name=UnbalancedEntries,json=unbalanced_entries,proto3" json:"UnbalancedEntries,omitempty"`
+	CheckedAt         *timestamppb.Timestamp    `protobuf:"bytes,5,opt,name=CheckedAt,json=checked_at,proto3" json:"CheckedAt,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *VerifyLedgerResponse) Reset() {
+	*x = VerifyLedgerResponse{}
+	mi := &file_proto_bank_type_ledger_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyLedgerResponse) ProtoMessage() {}
+
+func (x *VerifyLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bank_type_ledger_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyLedgerResponse.ProtoReflect.Descriptor instead.
+func (*VerifyLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bank_type_ledger_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VerifyLedgerResponse) GetBalanced() bool {
+	if x != nil {
+		return x.Balanced
+	}
+	return false
+}
+
+func (x *VerifyLedgerResponse) GetAccountsChecked() int64 {
+	if x != nil {
+		return x.AccountsChecked
+	}
+	return 0
+}
+
+func (x *VerifyLedgerResponse) GetMismatches() []*LedgerBalanceMismatch {
+	if x != nil {
+		return x.Mismatches
+	}
+	return nil
+}
+
+func (x *VerifyLedgerResponse) GetUnbalancedEntries() []*UnbalancedJournalEntry {
+	if x != nil {
+		return x.UnbalancedEntries
+	}
+	return nil
+}
+
+func (x *VerifyLedgerResponse) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+var File_proto_bank_type_ledger_proto protoreflect.FileDescriptor
+
+var file_proto_bank_type_ledger_proto_rawDesc = string([]byte{
+	0x0a, 0x1c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x2f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04,
+	0x62, 0x61, 0x6e, 0x6b, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x61, 0x6e,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x15, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xcf, 0x01, 0x0a, 0x15, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x69, 0x73, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x55,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x0d, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0e,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2b,
+	0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x0a, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x62, 0x0a, 0x16, 0x55,
+	0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x09, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x55, 0x55,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x09, 0x49, 0x6d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x69, 0x6d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22,
+	0xa2, 0x02, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12,
+	0x3b, 0x0a, 0x0a, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x11,
+	0x55, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x55,
+	0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x75, 0x6e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x5a, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x65, 0x6e,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_proto_bank_type_ledger_proto_rawDescOnce sync.Once
+	file_proto_bank_type_ledger_proto_rawDescData []byte
+)
+
+func file_proto_bank_type_ledger_proto_rawDescGZIP() []byte {
+	file_proto_bank_type_ledger_proto_rawDescOnce.Do(func() {
+		file_proto_bank_type_ledger_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_bank_type_ledger_proto_rawDesc), len(file_proto_bank_type_ledger_proto_rawDesc)))
+	})
+	return file_proto_bank_type_ledger_proto_rawDescData
+}
+
+var file_proto_bank_type_ledger_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proto_bank_type_ledger_proto_goTypes = []any{
+	(*VerifyLedgerRequest)(nil),    // 0: bank.VerifyLedgerRequest
+	(*LedgerBalanceMismatch)(nil),  // 1: bank.LedgerBalanceMismatch
+	(*UnbalancedJournalEntry)(nil), // 2: bank.UnbalancedJournalEntry
+	(*VerifyLedgerResponse)(nil),   // 3: bank.VerifyLedgerResponse
+	(*Money)(nil),                  // 4: bank.Money
+	(*timestamppb.Timestamp)(nil),  // 5: google.protobuf.Timestamp
+}
+var file_proto_bank_type_ledger_proto_depIdxs = []int32{
+	4, // 0: bank.LedgerBalanceMismatch.StoredBalance:type_name -> bank.Money
+	4, // 1: bank.LedgerBalanceMismatch.LedgerBalance:type_name -> bank.Money
+	4, // 2: bank.LedgerBalanceMismatch.Difference:type_name -> bank.Money
+	4, // 3: bank.UnbalancedJournalEntry.Imbalance:type_name -> bank.Money
+	1, // 4: bank.VerifyLedgerResponse.Mismatches:type_name -> bank.LedgerBalanceMismatch
+	2, // 5: bank.VerifyLedgerResponse.UnbalancedEntries:type_name -> bank.UnbalancedJournalEntry
+	5, // 6: bank.VerifyLedgerResponse.CheckedAt:type_name -> google.protobuf.Timestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_proto_bank_type_ledger_proto_init() }
+func file_proto_bank_type_ledger_proto_init() {
+	if File_proto_bank_type_ledger_proto != nil {
+		return
+	}
+	file_proto_bank_type_money_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_bank_type_ledger_proto_rawDesc), len(file_proto_bank_type_ledger_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_bank_type_ledger_proto_goTypes,
+		DependencyIndexes: file_proto_bank_type_ledger_proto_depIdxs,
+		MessageInfos:      file_proto_bank_type_ledger_proto_msgTypes,
+	}.Build()
+	File_proto_bank_type_ledger_proto = out.File
+	file_proto_bank_type_ledger_proto_goTypes = nil
+	file_proto_bank_type_ledger_proto_depIdxs = nil
+}
@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: proto/bank/type/money.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Currency int32
+
+const (
+	Currency_Currency_UNSPECEFIED Currency = 0
+	Currency_USD                  Currency = 1
+	Currency_JPY                  Currency = 2
+	Currency_CAD                  Currency = 3
+	Currency_EUR                  Currency = 4
+	Currency_GBP                  Currency = 5
+)
+
+// Enum value maps for Currency.
+var (
+	Currency_name = map[int32]string{
+		0: "Currency_UNSPECEFIED",
+		1: "USD",
+		2: "JPY",
+		3: "CAD",
+		4: "EUR",
+		5: "GBP",
+	}
+	Currency_value = map[string]int32{
+		"Currency_UNSPECEFIED": 0,
+		"USD":                  1,
+		"JPY":                  2,
+		"CAD":                  3,
+		"EUR":                  4,
+		"GBP":                  5,
+	}
+)
+
+func (x Currency) Enum() *Currency {
+	p := new(Currency)
+	*p = x
+	return p
+}
+
+func (x Currency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Currency) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_bank_type_money_proto_enumTypes[0].Descriptor()
+}
+
+func (Currency) Type() protoreflect.EnumType {
+	return &file_proto_bank_type_money_proto_enumTypes[0]
+}
+
+func (x Currency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Currency.Descriptor instead.
+func (Currency) EnumDescriptor() ([]byte, []int) {
+	return file_proto_bank_type_money_proto_rawDescGZIP(), []int{0}
+}
+
+// RoundingMode selects how converted amounts are rounded to the minor unit of the target currency.
+type RoundingMode int32
+
+const (
+	RoundingMode_RoundingMode_UNSPECIFIED RoundingMode = 0 // defaults to HalfEven
+	RoundingMode_HalfEven                 RoundingMode = 1
+	RoundingMode_HalfUp                   RoundingMode = 2
+	RoundingMode_Down                     RoundingMode = 3
+	RoundingMode_Up                       RoundingMode = 4
+)
+
+// Enum value maps for RoundingMode.
+var (
+	RoundingMode_name = map[int32]string{
+		0: "RoundingMode_UNSPECIFIED",
+		1: "HalfEven",
+		2: "HalfUp",
+		3: "Down",
+		4: "Up",
+	}
+	RoundingMode_value = map[string]int32{
+		"RoundingMode_UNSPECIFIED": 0,
+		"HalfEven":                 1,
+		"HalfUp":                   2,
+		"Down":                     3,
+		"Up":                       4,
+	}
+)
+
+func (x RoundingMode) Enum() *RoundingMode {
+	p := new(RoundingMode)
+	*p = x
+	return p
+}
+
+func (x RoundingMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoundingMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_bank_type_money_proto_enumTypes[1].Descriptor()
+}
+
+func (RoundingMode) Type() protoreflect.EnumType {
+	return &file_proto_bank_type_money_proto_enumTypes[1]
+}
+
+func (x RoundingMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoundingMode.Descriptor instead.
+func (RoundingMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_bank_type_money_proto_rawDescGZIP(), []int{1}
+}
+
+// Money is an exact amount of money in a specific currency, modeled after google.type.Money.
+// The amount is Units + Nanos * 10^-9 and Nanos must carry the same sign as Units.
+// Nanos must fit the currency minor unit, e.g. multiples of 10,000,000 for USD and zero for JPY.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      Currency               `protobuf:"varint,1,opt,name=Currency,json=currency,proto3,enum=bank.Currency" json:"Currency,omitempty"`
+	Units         int64                  `protobuf:"varint,2,opt,name=Units,json=units,proto3" json:"Units,omitempty"`
+	Nanos         int32                  `protobuf:"varint,3,opt,name=Nanos,json=nanos,proto3" json:"Nanos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_proto_bank_type_money_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bank_type_money_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_proto_bank_type_money_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetCurrency() Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return Currency_Currency_UNSPECEFIED
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Money) GetNanos() int32 {
+	if x != nil {
+		return x.Nanos
+	}
+	return 0
+}
+
+var File_proto_bank_type_money_proto protoreflect.FileDescriptor
+
+var file_proto_bank_type_money_proto_rawDesc = string([]byte{
+	0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x62,
+	0x61, 0x6e, 0x6b, 0x1a, 0x1e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x69, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x08,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x08,
+	0x8a, 0xb5, 0x18, 0x04, 0x08, 0x01, 0x30, 0x01, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x61, 0x6e, 0x6f,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x2a, 0x51,
+	0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x45, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x53, 0x44, 0x10, 0x01, 0x12, 0x07, 0x0a,
+	0x03, 0x4a, 0x50, 0x59, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x41, 0x44, 0x10, 0x03, 0x12,
+	0x07, 0x0a, 0x03, 0x45, 0x55, 0x52, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x42, 0x50, 0x10,
+	0x05, 0x2a, 0x58, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64,
+	0x65, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x48, 0x61, 0x6c, 0x66, 0x45, 0x76, 0x65, 0x6e, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x48, 0x61, 0x6c, 0x66, 0x55, 0x70, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x6f, 0x77,
+	0x6e, 0x10, 0x03, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x70, 0x10, 0x04, 0x42, 0x0d, 0x5a, 0x0b, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+})
+
+var (
+	file_proto_bank_type_money_proto_rawDescOnce sync.Once
+	file_proto_bank_type_money_proto_rawDescData []byte
+)
+
+func file_proto_bank_type_money_proto_rawDescGZIP() []byte {
+	file_proto_bank_type_money_proto_rawDescOnce.Do(func() {
+		file_proto_bank_type_money_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_bank_type_money_proto_rawDesc), len(file_proto_bank_type_money_proto_rawDesc)))
+	})
+	return file_proto_bank_type_money_proto_rawDescData
+}
+
+var file_proto_bank_type_money_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_bank_type_money_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_bank_type_money_proto_goTypes = []any{
+	(Currency)(0),     // 0: bank.Currency
+	(RoundingMode)(0), // 1: bank.RoundingMode
+	(*Money)(nil),     // 2: bank.Money
+}
+var file_proto_bank_type_money_proto_depIdxs = []int32{
+	0, // 0: bank.Money.Currency:type_name -> bank.Currency
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_proto_bank_type_money_proto_init() }
+func file_proto_bank_type_money_proto_init() {
+	if File_proto_bank_type_money_proto != nil {
+		return
+	}
+	file_proto_bank_type_validate_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_bank_type_money_proto_rawDesc), len(file_proto_bank_type_money_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_bank_type_money_proto_goTypes,
+		DependencyIndexes: file_proto_bank_type_money_proto_depIdxs,
+		EnumInfos:         file_proto_bank_type_money_proto_enumTypes,
+		MessageInfos:      file_proto_bank_type_money_proto_msgTypes,
+	}.Build()
+	File_proto_bank_type_money_proto = out.File
+	file_proto_bank_type_money_proto_goTypes = nil
+	file_proto_bank_type_money_proto_depIdxs = nil
+}
@@ -19,22 +19,56 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BankService_OpenAccount_FullMethodName       = "/bank.BankService/OpenAccount"
-	BankService_CreateTransaction_FullMethodName = "/bank.BankService/CreateTransaction"
-	BankService_GetCurrentBalance_FullMethodName = "/bank.BankService/GetCurrentBalance"
-	BankService_GetExchangeRate_FullMethodName   = "/bank.BankService/GetExchangeRate"
-	BankService_CreateTransfers_FullMethodName   = "/bank.BankService/CreateTransfers"
+	BankService_OpenAccount_FullMethodName            = "/bank.BankService/OpenAccount"
+	BankService_CreateTransaction_FullMethodName      = "/bank.BankService/CreateTransaction"
+	BankService_GetCurrentBalance_FullMethodName      = "/bank.BankService/GetCurrentBalance"
+	BankService_GetExchangeRate_FullMethodName        = "/bank.BankService/GetExchangeRate"
+	BankService_SubscribeExchangeRates_FullMethodName = "/bank.BankService/SubscribeExchangeRates"
+	BankService_GetExchangeRateAsOf_FullMethodName    = "/bank.BankService/GetExchangeRateAsOf"
+	BankService_GetExchangeRateHistory_FullMethodName = "/bank.BankService/GetExchangeRateHistory"
+	BankService_CreateTransfers_FullMethodName        = "/bank.BankService/CreateTransfers"
+	BankService_GetTransaction_FullMethodName         = "/bank.BankService/GetTransaction"
+	BankService_ListTransactions_FullMethodName       = "/bank.BankService/ListTransactions"
+	BankService_GetAccount_FullMethodName             = "/bank.BankService/GetAccount"
+	BankService_ListAccounts_FullMethodName           = "/bank.BankService/ListAccounts"
+	BankService_UpdateAccount_FullMethodName          = "/bank.BankService/UpdateAccount"
+	BankService_FreezeAccount_FullMethodName          = "/bank.BankService/FreezeAccount"
+	BankService_UnfreezeAccount_FullMethodName        = "/bank.BankService/UnfreezeAccount"
+	BankService_CloseAccount_FullMethodName           = "/bank.BankService/CloseAccount"
+	BankService_GetTransfer_FullMethodName            = "/bank.BankService/GetTransfer"
+	BankService_ListTransfers_FullMethodName          = "/bank.BankService/ListTransfers"
+	BankService_ReverseTransfer_FullMethodName        = "/bank.BankService/ReverseTransfer"
+	BankService_VerifyLedger_FullMethodName           = "/bank.BankService/VerifyLedger"
 )
 
 // BankServiceClient is the client API for BankService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BankService is also served as REST/JSON by the gateway following the google.api.http rules.
+// Path variables are bound to the request fields, the other fields of GET requests are read from the query string,
+// e.g. GET /v1/exchange-rates/EUR?amount.currency=USD&amount.units=10 streams the converted amount.
 type BankServiceClient interface {
 	OpenAccount(ctx context.Context, in *BankAccountCreateRequest, opts ...grpc.CallOption) (*BankAccountCreateResponse, error)
 	CreateTransaction(ctx context.Context, in *BankTransactionCreateRequest, opts ...grpc.CallOption) (*BankTransactionCreateResponse, error)
 	GetCurrentBalance(ctx context.Context, in *CurrentBalanceRequest, opts ...grpc.CallOption) (*CurrentBalanceResponse, error)
 	GetExchangeRate(ctx context.Context, in *ExchangeRateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExchangeRateResponse], error)
+	SubscribeExchangeRates(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ExchangeRateSubscriptionRequest, ExchangeRateUpdates], error)
+	GetExchangeRateAsOf(ctx context.Context, in *ExchangeRateAsOfRequest, opts ...grpc.CallOption) (*ExchangeRate, error)
+	GetExchangeRateHistory(ctx context.Context, in *ExchangeRateHistoryRequest, opts ...grpc.CallOption) (*ExchangeRateHistoryResponse, error)
 	CreateTransfers(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BankTransferRequest, BankTransferResponse], error)
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*BankTransaction, error)
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*BankAccount, error)
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*BankAccount, error)
+	FreezeAccount(ctx context.Context, in *AccountStatusRequest, opts ...grpc.CallOption) (*BankAccount, error)
+	UnfreezeAccount(ctx context.Context, in *AccountStatusRequest, opts ...grpc.CallOption) (*BankAccount, error)
+	CloseAccount(ctx context.Context, in *AccountStatusRequest, opts ...grpc.CallOption) (*BankAccount, error)
+	GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...grpc.CallOption) (*BankTransfer, error)
+	ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error)
+	ReverseTransfer(ctx context.Context, in *ReverseTransferRequest, opts ...grpc.CallOption) (*BankTransfer, error)
+	VerifyLedger(ctx context.Context, in *VerifyLedgerRequest, opts ...grpc.CallOption) (*VerifyLedgerResponse, error)
 }
 
 type bankServiceClient struct {
@@ -94,9 +128,42 @@ func (c *bankServiceClient) GetExchangeRate(ctx context.Context, in *ExchangeRat
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type BankService_GetExchangeRateClient = grpc.ServerStreamingClient[ExchangeRateResponse]
 
+func (c *bankServiceClient) SubscribeExchangeRates(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ExchangeRateSubscriptionRequest, ExchangeRateUpdates], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BankService_ServiceDesc.Streams[1], BankService_SubscribeExchangeRates_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExchangeRateSubscriptionRequest, ExchangeRateUpdates]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BankService_SubscribeExchangeRatesClient = grpc.BidiStreamingClient[ExchangeRateSubscriptionRequest, ExchangeRateUpdates]
+
+func (c *bankServiceClient) GetExchangeRateAsOf(ctx context.Context, in *ExchangeRateAsOfRequest, opts ...grpc.CallOption) (*ExchangeRate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExchangeRate)
+	err := c.cc.Invoke(ctx, BankService_GetExchangeRateAsOf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) GetExchangeRateHistory(ctx context.Context, in *ExchangeRateHistoryRequest, opts ...grpc.CallOption) (*ExchangeRateHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExchangeRateHistoryResponse)
+	err := c.cc.Invoke(ctx, BankService_GetExchangeRateHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bankServiceClient) CreateTransfers(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BankTransferRequest, BankTransferResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &BankService_ServiceDesc.Streams[1], BankService_CreateTransfers_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &BankService_ServiceDesc.Streams[2], BankService_CreateTransfers_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -107,15 +174,154 @@ func (c *bankServiceClient) CreateTransfers(ctx context.Context, opts ...grpc.Ca
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type BankService_CreateTransfersClient = grpc.BidiStreamingClient[BankTransferRequest, BankTransferResponse]
 
+func (c *bankServiceClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*BankTransaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BankTransaction)
+	err := c.cc.Invoke(ctx, BankService_GetTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, BankService_ListTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*BankAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BankAccount)
+	err := c.cc.Invoke(ctx, BankService_GetAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, BankService_ListAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*BankAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BankAccount)
+	err := c.cc.Invoke(ctx, BankService_UpdateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) FreezeAccount(ctx context.Context, in *AccountStatusRequest, opts ...grpc.CallOption) (*BankAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BankAccount)
+	err := c.cc.Invoke(ctx, BankService_FreezeAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) UnfreezeAccount(ctx context.Context, in *AccountStatusRequest, opts ...grpc.CallOption) (*BankAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BankAccount)
+	err := c.cc.Invoke(ctx, BankService_UnfreezeAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) CloseAccount(ctx context.Context, in *AccountStatusRequest, opts ...grpc.CallOption) (*BankAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BankAccount)
+	err := c.cc.Invoke(ctx, BankService_CloseAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...grpc.CallOption) (*BankTransfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BankTransfer)
+	err := c.cc.Invoke(ctx, BankService_GetTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransfersResponse)
+	err := c.cc.Invoke(ctx, BankService_ListTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) ReverseTransfer(ctx context.Context, in *ReverseTransferRequest, opts ...grpc.CallOption) (*BankTransfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BankTransfer)
+	err := c.cc.Invoke(ctx, BankService_ReverseTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankServiceClient) VerifyLedger(ctx context.Context, in *VerifyLedgerRequest, opts ...grpc.CallOption) (*VerifyLedgerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyLedgerResponse)
+	err := c.cc.Invoke(ctx, BankService_VerifyLedger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BankServiceServer is the server API for BankService service.
 // All implementations must embed UnimplementedBankServiceServer
 // for forward compatibility.
+//
+// BankService is also served as REST/JSON by the gateway following the google.api.http rules.
+// Path variables are bound to the request fields, the other fields of GET requests are read from the query string,
+// e.g. GET /v1/exchange-rates/EUR?amount.currency=USD&amount.units=10 streams the converted amount.
 type BankServiceServer interface {
 	OpenAccount(context.Context, *BankAccountCreateRequest) (*BankAccountCreateResponse, error)
 	CreateTransaction(context.Context, *BankTransactionCreateRequest) (*BankTransactionCreateResponse, error)
 	GetCurrentBalance(context.Context, *CurrentBalanceRequest) (*CurrentBalanceResponse, error)
 	GetExchangeRate(*ExchangeRateRequest, grpc.ServerStreamingServer[ExchangeRateResponse]) error
+	SubscribeExchangeRates(grpc.BidiStreamingServer[ExchangeRateSubscriptionRequest, ExchangeRateUpdates]) error
+	GetExchangeRateAsOf(context.Context, *ExchangeRateAsOfRequest) (*ExchangeRate, error)
+	GetExchangeRateHistory(context.Context, *ExchangeRateHistoryRequest) (*ExchangeRateHistoryResponse, error)
 	CreateTransfers(grpc.BidiStreamingServer[BankTransferRequest, BankTransferResponse]) error
+	GetTransaction(context.Context, *GetTransactionRequest) (*BankTransaction, error)
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	GetAccount(context.Context, *GetAccountRequest) (*BankAccount, error)
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	UpdateAccount(context.Context, *UpdateAccountRequest) (*BankAccount, error)
+	FreezeAccount(context.Context, *AccountStatusRequest) (*BankAccount, error)
+	UnfreezeAccount(context.Context, *AccountStatusRequest) (*BankAccount, error)
+	CloseAccount(context.Context, *AccountStatusRequest) (*BankAccount, error)
+	GetTransfer(context.Context, *GetTransferRequest) (*BankTransfer, error)
+	ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error)
+	ReverseTransfer(context.Context, *ReverseTransferRequest) (*BankTransfer, error)
+	VerifyLedger(context.Context, *VerifyLedgerRequest) (*VerifyLedgerResponse, error)
 	mustEmbedUnimplementedBankServiceServer()
 }
 
@@ -138,9 +344,54 @@ func (UnimplementedBankServiceServer) GetCurrentBalance(context.Context, *Curren
 func (UnimplementedBankServiceServer) GetExchangeRate(*ExchangeRateRequest, grpc.ServerStreamingServer[ExchangeRateResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method GetExchangeRate not implemented")
 }
+func (UnimplementedBankServiceServer) SubscribeExchangeRates(grpc.BidiStreamingServer[ExchangeRateSubscriptionRequest, ExchangeRateUpdates]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeExchangeRates not implemented")
+}
+func (UnimplementedBankServiceServer) GetExchangeRateAsOf(context.Context, *ExchangeRateAsOfRequest) (*ExchangeRate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeRateAsOf not implemented")
+}
+func (UnimplementedBankServiceServer) GetExchangeRateHistory(context.Context, *ExchangeRateHistoryRequest) (*ExchangeRateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeRateHistory not implemented")
+}
 func (UnimplementedBankServiceServer) CreateTransfers(grpc.BidiStreamingServer[BankTransferRequest, BankTransferResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method CreateTransfers not implemented")
 }
+func (UnimplementedBankServiceServer) GetTransaction(context.Context, *GetTransactionRequest) (*BankTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedBankServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedBankServiceServer) GetAccount(context.Context, *GetAccountRequest) (*BankAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedBankServiceServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+}
+func (UnimplementedBankServiceServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*BankAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccount not implemented")
+}
+func (UnimplementedBankServiceServer) FreezeAccount(context.Context, *AccountStatusRequest) (*BankAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeAccount not implemented")
+}
+func (UnimplementedBankServiceServer) UnfreezeAccount(context.Context, *AccountStatusRequest) (*BankAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeAccount not implemented")
+}
+func (UnimplementedBankServiceServer) CloseAccount(context.Context, *AccountStatusRequest) (*BankAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAccount not implemented")
+}
+func (UnimplementedBankServiceServer) GetTransfer(context.Context, *GetTransferRequest) (*BankTransfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransfer not implemented")
+}
+func (UnimplementedBankServiceServer) ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransfers not implemented")
+}
+func (UnimplementedBankServiceServer) ReverseTransfer(context.Context, *ReverseTransferRequest) (*BankTransfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseTransfer not implemented")
+}
+func (UnimplementedBankServiceServer) VerifyLedger(context.Context, *VerifyLedgerRequest) (*VerifyLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyLedger not implemented")
+}
 func (UnimplementedBankServiceServer) mustEmbedUnimplementedBankServiceServer() {}
 func (UnimplementedBankServiceServer) testEmbeddedByValue()                     {}
 
@@ -227,6 +478,49 @@ func _BankService_GetExchangeRate_Handler(srv interface{}, stream grpc.ServerStr
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type BankService_GetExchangeRateServer = grpc.ServerStreamingServer[ExchangeRateResponse]
 
+func _BankService_SubscribeExchangeRates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BankServiceServer).SubscribeExchangeRates(&grpc.GenericServerStream[ExchangeRateSubscriptionRequest, ExchangeRateUpdates]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BankService_SubscribeExchangeRatesServer = grpc.BidiStreamingServer[ExchangeRateSubscriptionRequest, ExchangeRateUpdates]
+
+func _BankService_GetExchangeRateAsOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeRateAsOfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).GetExchangeRateAsOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_GetExchangeRateAsOf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).GetExchangeRateAsOf(ctx, req.(*ExchangeRateAsOfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_GetExchangeRateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeRateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).GetExchangeRateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_GetExchangeRateHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).GetExchangeRateHistory(ctx, req.(*ExchangeRateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BankService_CreateTransfers_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(BankServiceServer).CreateTransfers(&grpc.GenericServerStream[BankTransferRequest, BankTransferResponse]{ServerStream: stream})
 }
@@ -234,6 +528,222 @@ func _BankService_CreateTransfers_Handler(srv interface{}, stream grpc.ServerStr
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type BankService_CreateTransfersServer = grpc.BidiStreamingServer[BankTransferRequest, BankTransferResponse]
 
+func _BankService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_GetTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ListAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).UpdateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_UpdateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_FreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).FreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_FreezeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).FreezeAccount(ctx, req.(*AccountStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_UnfreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).UnfreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_UnfreezeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).UnfreezeAccount(ctx, req.(*AccountStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_CloseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).CloseAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_CloseAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).CloseAccount(ctx, req.(*AccountStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_GetTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).GetTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_GetTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).GetTransfer(ctx, req.(*GetTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ListTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ListTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ListTransfers(ctx, req.(*ListTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_ReverseTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).ReverseTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_ReverseTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).ReverseTransfer(ctx, req.(*ReverseTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankService_VerifyLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankServiceServer).VerifyLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankService_VerifyLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankServiceServer).VerifyLedger(ctx, req.(*VerifyLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BankService_ServiceDesc is the grpc.ServiceDesc for BankService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -253,6 +763,62 @@ var BankService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrentBalance",
 			Handler:    _BankService_GetCurrentBalance_Handler,
 		},
+		{
+			MethodName: "GetExchangeRateAsOf",
+			Handler:    _BankService_GetExchangeRateAsOf_Handler,
+		},
+		{
+			MethodName: "GetExchangeRateHistory",
+			Handler:    _BankService_GetExchangeRateHistory_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _BankService_GetTransaction_Handler,
+		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _BankService_ListTransactions_Handler,
+		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _BankService_GetAccount_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _BankService_ListAccounts_Handler,
+		},
+		{
+			MethodName: "UpdateAccount",
+			Handler:    _BankService_UpdateAccount_Handler,
+		},
+		{
+			MethodName: "FreezeAccount",
+			Handler:    _BankService_FreezeAccount_Handler,
+		},
+		{
+			MethodName: "UnfreezeAccount",
+			Handler:    _BankService_UnfreezeAccount_Handler,
+		},
+		{
+			MethodName: "CloseAccount",
+			Handler:    _BankService_CloseAccount_Handler,
+		},
+		{
+			MethodName: "GetTransfer",
+			Handler:    _BankService_GetTransfer_Handler,
+		},
+		{
+			MethodName: "ListTransfers",
+			Handler:    _BankService_ListTransfers_Handler,
+		},
+		{
+			MethodName: "ReverseTransfer",
+			Handler:    _BankService_ReverseTransfer_Handler,
+		},
+		{
+			MethodName: "VerifyLedger",
+			Handler:    _BankService_VerifyLedger_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -260,6 +826,12 @@ var BankService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _BankService_GetExchangeRate_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeExchangeRates",
+			Handler:       _BankService_SubscribeExchangeRates_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "CreateTransfers",
 			Handler:       _BankService_CreateTransfers_Handler,
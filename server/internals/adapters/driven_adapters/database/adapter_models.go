@@ -16,7 +16,8 @@ type BankTransactionModel struct {
 	BankAccount          *BankAccountModel `bun:"rel:belongs-to,join:account_uuid=account_uuid"`
 	AccountUUID          uuid.UUID
 	TransactionTimestamp time.Time `bun:",type:timestamptz,notnull"`
-	Amount               float64   `bun:",type:numeric(15,2),notnull"`
+	Amount               string    `bun:",type:numeric(15,2),notnull"`
+	Currency             string    `bun:",type:varchar(5),notnull"`
 	TransactionType      string    `bun:",type:varchar(25),notnull"`
 	Notes                string    `bun:",type:text"`
 	CreatedAt            time.Time `bun:",type:timestamptz,nullzero,notnull,default:current_timestamp"`
@@ -31,7 +32,11 @@ type BankAccountModel struct {
 	AccountNumber   string                  `bun:",type:varchar(20),unique,notnull"`
 	AccountName     string                  `bun:",type:varchar(100),notnull"`
 	Currency        string                  `bun:",type:varchar(5),notnull"`
-	CurrentBalance  float64                 `bun:",type:numeric(15,2),notnull"`
+	CurrentBalance  string                  `bun:",type:numeric(15,2),notnull"`
+	OverdraftLimit  string                  `bun:",type:numeric(15,2),notnull"`
+	Status          string                  `bun:",type:varchar(10),nullzero,notnull,default:'active'"`
+	OwnerSubject    string                  `bun:",type:varchar(255),nullzero"`
+	ClosedAt        time.Time               `bun:",type:timestamptz,nullzero"`
 	CreatedAt       time.Time               `bun:",type:timestamptz,nullzero,notnull,default:current_timestamp"`
 	UpdatedAt       time.Time               `bun:",type:timestsamptz,nullzero,notnull"`
 	BankTransaction []*BankTransactionModel `bun:"rel:has-many,join:account_uuid=account_uuid"`
@@ -45,13 +50,39 @@ type ExchangeRateModel struct {
 	ExchangeRateUUID   uuid.UUID `bun:",type:uuid,unique,notnull"`
 	FromCurrency       string    `bun:",type:varchar(5),notnull"`
 	ToCurrency         string    `bun:",type:varchar(5),notnull"`
-	Rate               float64   `bun:",type:numeric(20,5),notnull,nullzero"`
+	Rate               string    `bun:",type:numeric(20,10),notnull,nullzero"`
 	ValidFromTimestamp time.Time `bun:",type:timestamptz,nullzero,notnull"`
 	ValidToTimestamp   time.Time `bun:",type:timestamptz,nullzero,notnull"`
+	Sequence           int64     `bun:",type:bigint,notnull"` // maintained by the database, bumped on every rate change
 	CreatedAt          time.Time `bun:",type:timestamptz,nullzero,notnull"`
 	UpdatedAt          time.Time `bun:",type:timestamptz,nullzero,notnull"`
 }
 
+type ExchangeRateHistoryModels []ExchangeRateHistoryModel
+
+type ExchangeRateHistoryModel struct {
+	bun.BaseModel      `bun:"table:bank_exchange_rate_history"`
+	HistoryUUID        uuid.UUID `bun:",type:uuid,notnull,nullzero,unique"`
+	ExchangeRateUUID   uuid.UUID `bun:",type:uuid,notnull"`
+	FromCurrency       string    `bun:",type:varchar(5),notnull"`
+	ToCurrency         string    `bun:",type:varchar(5),notnull"`
+	Rate               string    `bun:",type:numeric(20,10),notnull,nullzero"`
+	ValidFromTimestamp time.Time `bun:",type:timestamptz,nullzero,notnull"`
+	ValidToTimestamp   time.Time `bun:",type:timestamptz,nullzero,notnull"`
+	Sequence           int64     `bun:",type:bigint,notnull"`
+	RecordedAt         time.Time `bun:",type:timestamptz,nullzero,notnull"`
+}
+
+// ExchangeRateBucketModel aggregates the rate changes of a pair which took effect during an interval of a history query
+type ExchangeRateBucketModel struct {
+	Bucket  int64  `bun:"bucket"` // index of the interval from the start of the query
+	Open    string `bun:"open"`   // first rate of the interval
+	High    string `bun:"high"`
+	Low     string `bun:"low"`
+	Close   string `bun:"close"` // last rate of the interval
+	Changes int    `bun:"changes"`
+}
+
 type BankTransfersModel []BankTransferModel
 
 type BankTransferModel struct {
@@ -62,20 +93,87 @@ type BankTransferModel struct {
 	FromAccountUUID   uuid.UUID         `bun:",type:uuid,notnull"`
 	ToAccountUUID     uuid.UUID         `bun:",type:uuid,notnull"`
 	Currency          string            `bun:",type:varchar(20),notnull"`
-	Amount            float64           `bun:",type:numeric(15,2),notnull,unique"`
+	Amount            string            `bun:",type:numeric(15,2),notnull"`
+	DebitCurrency     string            `bun:",type:varchar(20),nullzero"`
+	DebitAmount       string            `bun:",type:numeric(15,2),nullzero"`
 	TransferTimestamp time.Time         `bun:",type:timestamptz,notnull,nullzero"`
-	TransferSucceed   bool              `bun:",type:boolean,notnull"`
+	Status            string            `bun:",type:varchar(10),nullzero,notnull,default:'pending'"`
+	FailureReason     string            `bun:",type:text,nullzero"`
 	CreatedAt         time.Time         `bun:",type:timestamptz,notnull,nullzero"`
 	UpdatedAt         time.Time         `bun:",type:timestamptz,notnull,nullzero"`
 }
 
+type TransferStatusHistoryModels []TransferStatusHistoryModel
+
+type TransferStatusHistoryModel struct {
+	bun.BaseModel `bun:"table:bank_transfer_status_history"`
+	HistoryUUID   uuid.UUID `bun:",pk,type:uuid,nullzero,notnull,default:gen_random_uuid()"`
+	TransferUUID  uuid.UUID `bun:",type:uuid,notnull"`
+	FromStatus    string    `bun:",type:varchar(10),nullzero"`
+	ToStatus      string    `bun:",type:varchar(10),notnull"`
+	Reason        string    `bun:",type:text,nullzero"`
+	ChangedAt     time.Time `bun:",type:timestamptz,nullzero,notnull,default:current_timestamp"`
+}
+
+type IdempotencyKeyModel struct {
+	bun.BaseModel  `bun:"table:idempotency_keys"`
+	Principal      string    `bun:",pk,type:varchar(300),notnull"`
+	Operation      string    `bun:",pk,type:varchar(50),notnull"`
+	IdempotencyKey string    `bun:",pk,type:varchar(255),notnull"`
+	RequestHash    string    `bun:",type:char(64),notnull"`
+	ResourceUUID   uuid.UUID `bun:",type:uuid,nullzero"`
+	CreatedAt      time.Time `bun:",type:timestamptz,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt      time.Time `bun:",type:timestamptz,nullzero,notnull"`
+}
+
+type LedgerEntryModel struct {
+	bun.BaseModel `bun:"table:ledger_entries"`
+	EntryUUID     uuid.UUID `bun:",pk,type:uuid,nullzero,notnull,default:gen_random_uuid()"`
+	EntryType     string    `bun:",type:varchar(25),notnull"`
+	ReferenceUUID uuid.UUID `bun:",type:uuid,nullzero"`
+	Description   string    `bun:",type:text,nullzero"`
+	CreatedAt     time.Time `bun:",type:timestamptz,nullzero,notnull,default:current_timestamp"`
+}
+
+type LedgerPostingsModel []LedgerPostingModel
+
+type LedgerPostingModel struct {
+	bun.BaseModel `bun:"table:ledger_postings"`
+	PostingUUID   uuid.UUID `bun:",pk,type:uuid,nullzero,notnull,default:gen_random_uuid()"`
+	EntryUUID     uuid.UUID `bun:",type:uuid,notnull"`
+	AccountUUID   uuid.UUID `bun:",type:uuid,nullzero"`
+	SystemAccount string    `bun:",type:varchar(30),nullzero"`
+	Currency      string    `bun:",type:varchar(5),notnull"`
+	Amount        string    `bun:",type:numeric(15,2),notnull"`
+	CreatedAt     time.Time `bun:",type:timestamptz,nullzero,notnull,default:current_timestamp"`
+}
+
+// LedgerBalanceMismatchModel is a row of the ledger balance verification query
+type LedgerBalanceMismatchModel struct {
+	AccountUUID    uuid.UUID `bun:"account_uuid"`
+	Currency       string    `bun:"currency"`
+	CurrentBalance string    `bun:"current_balance"`
+	LedgerBalance  string    `bun:"ledger_balance"`
+}
+
+// UnbalancedEntryModel is a row of the journal entry balance verification query
+type UnbalancedEntryModel struct {
+	EntryUUID uuid.UUID `bun:"entry_uuid"`
+	Currency  string    `bun:"currency"`
+	Imbalance string    `bun:"imbalance"`
+}
+
 func NewBankAccountModel(ba *domains.BankAccount) *BankAccountModel {
 	return &BankAccountModel{
 		AccountUUID:    ba.AccountUUID,
 		AccountNumber:  ba.AccountNumber,
 		AccountName:    ba.AccountName,
 		Currency:       ba.Currency,
-		CurrentBalance: ba.CurrentBalance,
+		CurrentBalance: ba.CurrentBalance.Decimal(),
+		OverdraftLimit: ba.OverdraftLimit.Decimal(),
+		Status:         ba.Status,
+		OwnerSubject:   ba.OwnerSubject,
+		ClosedAt:       ba.ClosedAt,
 		UpdatedAt:      ba.UpdatedAt,
 	}
 }
@@ -84,7 +182,8 @@ func NewBankTransactionModel(bt *domains.BankTransaction) *BankTransactionModel
 	return &BankTransactionModel{
 		TransactionUUID:      bt.TransactionUUID,
 		AccountUUID:          bt.AccountUUID,
-		Amount:               bt.Amount,
+		Amount:               bt.Amount.Decimal(),
+		Currency:             bt.Amount.Currency,
 		TransactionTimestamp: bt.TransactionTimestamp,
 		TransactionType:      bt.TransactionType,
 		Notes:                bt.Notes,
@@ -93,7 +192,7 @@ func NewBankTransactionModel(bt *domains.BankTransaction) *BankTransactionModel
 	}
 }
 
-func NewExchangeRateModel(srcCurrency string, dstCurrency string, rate float64) *ExchangeRateModel {
+func NewExchangeRateModel(srcCurrency string, dstCurrency string, rate string) *ExchangeRateModel {
 	startTime := time.Now()
 	return &ExchangeRateModel{
 		FromCurrency:       srcCurrency,
@@ -106,16 +205,192 @@ func NewExchangeRateModel(srcCurrency string, dstCurrency string, rate float64)
 	}
 }
 
+// ToExchangeRate returns the history entry as the exchange rate it was when recorded
+func (m *ExchangeRateHistoryModel) ToExchangeRate() *ExchangeRateModel {
+	return &ExchangeRateModel{
+		ExchangeRateUUID:   m.ExchangeRateUUID,
+		FromCurrency:       m.FromCurrency,
+		ToCurrency:         m.ToCurrency,
+		Rate:               m.Rate,
+		ValidFromTimestamp: m.ValidFromTimestamp,
+		ValidToTimestamp:   m.ValidToTimestamp,
+		Sequence:           m.Sequence,
+		UpdatedAt:          m.RecordedAt,
+	}
+}
+
+func NewLedgerEntryModel(e *domains.JournalEntry) (*LedgerEntryModel, LedgerPostingsModel) {
+	postings := make(LedgerPostingsModel, 0, len(e.Postings))
+	for _, p := range e.Postings {
+		postings = append(postings, LedgerPostingModel{
+			EntryUUID:     e.EntryUUID,
+			AccountUUID:   p.AccountUUID,
+			SystemAccount: p.SystemAccount,
+			Currency:      p.Amount.Currency,
+			Amount:        p.Amount.Decimal(),
+			CreatedAt:     e.CreatedAt,
+		})
+	}
+	return &LedgerEntryModel{
+		EntryUUID:     e.EntryUUID,
+		EntryType:     e.EntryType,
+		ReferenceUUID: e.ReferenceUUID,
+		Description:   e.Description,
+		CreatedAt:     e.CreatedAt,
+	}, postings
+}
+
+func NewIdempotencyKeyModel(ik *domains.IdempotencyKey) *IdempotencyKeyModel {
+	return &IdempotencyKeyModel{
+		Principal:      ik.Principal,
+		Operation:      ik.Operation,
+		IdempotencyKey: ik.Key,
+		RequestHash:    ik.RequestHash,
+		ResourceUUID:   ik.ResourceUUID,
+		CreatedAt:      ik.CreatedAt,
+		UpdatedAt:      ik.UpdatedAt,
+	}
+}
+
 func NewTransferModel(nt *domains.BankTransfer) *BankTransferModel {
-	return &BankTransferModel{
+	model := &BankTransferModel{
 		TransferUUID:      nt.TransferUUID,
 		FromAccountUUID:   nt.FromAccountUUID,
 		ToAccountUUID:     nt.ToAccountUUID,
-		Currency:          nt.Currency,
-		Amount:            nt.Amount,
+		Currency:          nt.Amount.Currency,
+		Amount:            nt.Amount.Decimal(),
 		TransferTimestamp: nt.TransferTimestamp,
+		Status:            nt.Status,
+		FailureReason:     nt.FailureReason,
 		CreatedAt:         nt.CreatedAt,
 		UpdatedAt:         nt.UpdatedAt,
-		TransferSucceed:   nt.TransferSucceed,
 	}
+	if nt.DebitAmount != nil {
+		model.DebitCurrency = nt.DebitAmount.Currency
+		model.DebitAmount = nt.DebitAmount.Decimal()
+	}
+	return model
+}
+
+func NewTransferStatusHistoryModel(transferUUID uuid.UUID, change *domains.TransferStatusChange) *TransferStatusHistoryModel {
+	return &TransferStatusHistoryModel{
+		TransferUUID: transferUUID,
+		FromStatus:   change.FromStatus,
+		ToStatus:     change.ToStatus,
+		Reason:       change.Reason,
+		ChangedAt:    change.ChangedAt,
+	}
+}
+
+// ToBankAccount converts the database model to the bank account domain entity
+func (m *BankAccountModel) ToBankAccount() (*domains.BankAccount, error) {
+	balance, err := domains.ParseMoney(m.CurrentBalance, m.Currency)
+	if err != nil {
+		return nil, err
+	}
+	overdraftLimit, err := domains.ParseMoney(m.OverdraftLimit, m.Currency)
+	if err != nil {
+		return nil, err
+	}
+	return &domains.BankAccount{
+		AccountUUID:    m.AccountUUID,
+		AccountNumber:  m.AccountNumber,
+		AccountName:    m.AccountName,
+		Currency:       m.Currency,
+		CurrentBalance: balance,
+		OverdraftLimit: overdraftLimit,
+		Status:         m.Status,
+		OwnerSubject:   m.OwnerSubject,
+		ClosedAt:       m.ClosedAt,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}, nil
+}
+
+// ToBankTransaction converts the database model to the bank transaction domain entity
+func (m *BankTransactionModel) ToBankTransaction() (*domains.BankTransaction, error) {
+	amount, err := domains.ParseMoney(m.Amount, m.Currency)
+	if err != nil {
+		return nil, err
+	}
+	return &domains.BankTransaction{
+		TransactionUUID:      m.TransactionUUID,
+		AccountUUID:          m.AccountUUID,
+		TransactionTimestamp: m.TransactionTimestamp,
+		Amount:               amount,
+		TransactionType:      m.TransactionType,
+		Notes:                m.Notes,
+		CreatedAt:            m.CreatedAt,
+		UpdatedAt:            m.UpdatedAt,
+	}, nil
+}
+
+// ToBankTransfer converts the database model to the bank transfer domain entity
+func (m *BankTransferModel) ToBankTransfer() (*domains.BankTransfer, error) {
+	amount, err := domains.ParseMoney(m.Amount, m.Currency)
+	if err != nil {
+		return nil, err
+	}
+	transfer := &domains.BankTransfer{
+		TransferUUID:      m.TransferUUID,
+		FromAccountUUID:   m.FromAccountUUID,
+		ToAccountUUID:     m.ToAccountUUID,
+		Amount:            amount,
+		TransferTimestamp: m.TransferTimestamp,
+		Status:            m.Status,
+		FailureReason:     m.FailureReason,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+	}
+	if m.DebitAmount != "" {
+		debitAmount, err := domains.ParseMoney(m.DebitAmount, m.DebitCurrency)
+		if err != nil {
+			return nil, err
+		}
+		transfer.DebitAmount = &debitAmount
+	}
+	return transfer, nil
+}
+
+// ToStatusChanges converts the history models to the transfer status history, oldest first as stored
+func (m TransferStatusHistoryModels) ToStatusChanges() []domains.TransferStatusChange {
+	changes := make([]domains.TransferStatusChange, 0, len(m))
+	for _, h := range m {
+		changes = append(changes, domains.TransferStatusChange{
+			FromStatus: h.FromStatus,
+			ToStatus:   h.ToStatus,
+			Reason:     h.Reason,
+			ChangedAt:  h.ChangedAt,
+		})
+	}
+	return changes
+}
+
+// ToLedgerBalanceMismatch converts the verification row to the ledger balance mismatch domain entity
+func (m *LedgerBalanceMismatchModel) ToLedgerBalanceMismatch() (*domains.LedgerBalanceMismatch, error) {
+	stored, err := domains.ParseMoney(m.CurrentBalance, m.Currency)
+	if err != nil {
+		return nil, err
+	}
+	ledger, err := domains.ParseMoney(m.LedgerBalance, m.Currency)
+	if err != nil {
+		return nil, err
+	}
+	return &domains.LedgerBalanceMismatch{
+		AccountUUID:   m.AccountUUID,
+		StoredBalance: stored,
+		LedgerBalance: ledger,
+	}, nil
+}
+
+// ToUnbalancedJournalEntry converts the verification row to the unbalanced journal entry domain entity
+func (m *UnbalancedEntryModel) ToUnbalancedJournalEntry() (*domains.UnbalancedJournalEntry, error) {
+	imbalance, err := domains.ParseMoney(m.Imbalance, m.Currency)
+	if err != nil {
+		return nil, err
+	}
+	return &domains.UnbalancedJournalEntry{
+		EntryUUID: m.EntryUUID,
+		Imbalance: imbalance,
+	}, nil
 }
@@ -3,6 +3,7 @@ package adapters
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 
 	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
@@ -29,9 +30,9 @@ func (br *BankAccountRepository) Create(pCtx context.Context, ba *domains.BankAc
 	defer cancel()
 
 	nBankAccountModel := NewBankAccountModel(ba)
-	_, err := br.db.NewInsert().Model(nBankAccountModel).Exec(ctx, nBankAccountModel)
+	_, err := dbConn(ctx, br.db).NewInsert().Model(nBankAccountModel).Exec(ctx, nBankAccountModel)
 	if err != nil {
-		br.logger.Error().Err(err).
+		br.logger.Error().Ctx(ctx).Err(err).
 			Str("account_uuid", ba.AccountUUID.String()).
 			Str("account_number", ba.AccountNumber).
 			Msg("failed to create bank account")
@@ -40,36 +41,92 @@ func (br *BankAccountRepository) Create(pCtx context.Context, ba *domains.BankAc
 	return nBankAccountModel, nil
 }
 
-func (br *BankAccountRepository) DeleteByID(pCtx context.Context, accID uuid.UUID) error {
+// Close soft deletes the bank account by marking it closed. Only accounts with a zero balance can be closed,
+// the row is kept so the transactions and transfers of the account never lose their reference.
+func (br *BankAccountRepository) Close(pCtx context.Context, accID uuid.UUID) (*BankAccountModel, error) {
 	ctx, cancel := context.WithTimeout(pCtx, time.Second*5)
 	defer cancel()
 
-	result, err := br.db.NewDelete().Model((*BankAccountModel)(nil)).Where("account_uuid = ?", accID).Exec(ctx)
+	nBankAccountModel := &BankAccountModel{}
+	result, err := dbConn(ctx, br.db).NewUpdate().
+		Model(nBankAccountModel).
+		Set("status = ?", domains.AccountStatusClosed).
+		Set("closed_at = ?", time.Now()).
+		Set("updated_at = ?", time.Now()).
+		Where("account_uuid = ?", accID).
+		Where("status <> ?", domains.AccountStatusClosed).
+		Where("current_balance = 0").
+		Returning("*").
+		Exec(ctx, nBankAccountModel)
 	if err != nil {
-		br.logger.Error().Err(err).
+		br.logger.Error().Ctx(ctx).Err(err).
 			Str("account_uuid", accID.String()).
-			Msg("failed to delete bank account")
-		return domainsErrors.DatabaseError(err, "delete bank account")
+			Msg("failed to close bank account")
+		return nil, domainsErrors.DatabaseError(err, "close bank account")
 	}
 
-	// Check if any rows were affected
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		br.logger.Error().Err(err).
+		br.logger.Error().Ctx(ctx).Err(err).
 			Str("account_uuid", accID.String()).
-			Msg("failed to get rows affected after delete operation")
-		return domainsErrors.DatabaseError(err, "check delete result")
+			Msg("failed to get rows affected after close operation")
+		return nil, domainsErrors.DatabaseError(err, "check close result")
 	}
 
-	// If no rows were affected, the account wasn't found
+	// If no rows were affected, the account wasn't found, is already closed or still holds money
 	if rowsAffected == 0 {
-		br.logger.Warn().
+		nAccount, err := br.GetByID(pCtx, accID)
+		if err != nil {
+			return nil, err
+		}
+		if nAccount.Status == domains.AccountStatusClosed {
+			return nil, domainsErrors.AccountClosedError(accID.String())
+		}
+
+		br.logger.Warn().Ctx(ctx).
 			Str("account_uuid", accID.String()).
-			Msg("no bank account found to delete")
-		return domainsErrors.NotFoundError("bank account", accID.String())
+			Str("current_balance", nAccount.CurrentBalance).
+			Msg("can't close bank account with a non-zero balance")
+		return nil, domainsErrors.AccountBalanceNotZeroError(accID.String(), nAccount.CurrentBalance)
+	}
+
+	return nBankAccountModel, nil
+}
+
+// SetStatus moves an open account between the active and frozen states. Closed accounts can't be reopened.
+func (br *BankAccountRepository) SetStatus(pCtx context.Context, accID uuid.UUID, status string) (*BankAccountModel, error) {
+	ctx, cancel := context.WithTimeout(pCtx, time.Second*5)
+	defer cancel()
+
+	nBankAccountModel := &BankAccountModel{}
+	result, err := dbConn(ctx, br.db).NewUpdate().
+		Model(nBankAccountModel).
+		Set("status = ?", status).
+		Set("updated_at = ?", time.Now()).
+		Where("account_uuid = ?", accID).
+		Where("status <> ?", domains.AccountStatusClosed).
+		Returning("*").
+		Exec(ctx, nBankAccountModel)
+	if err != nil {
+		br.logger.Error().Ctx(ctx).Err(err).
+			Str("account_uuid", accID.String()).
+			Str("status", status).
+			Msg("failed to change bank account status")
+		return nil, domainsErrors.DatabaseError(err, "change bank account status")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, domainsErrors.DatabaseError(err, "check status change result")
+	}
+	if rowsAffected == 0 {
+		if _, err := br.GetByID(pCtx, accID); err != nil {
+			return nil, err
+		}
+		return nil, domainsErrors.AccountClosedError(accID.String())
 	}
 
-	return nil
+	return nBankAccountModel, nil
 }
 
 func (br *BankAccountRepository) GetByID(pCtx context.Context, accID uuid.UUID) (*BankAccountModel, error) {
@@ -77,16 +134,16 @@ func (br *BankAccountRepository) GetByID(pCtx context.Context, accID uuid.UUID)
 	defer cancel()
 
 	nAccount := &BankAccountModel{}
-	err := br.db.NewSelect().Model(nAccount).Where("account_uuid = ?", accID).Scan(ctx)
+	err := dbConn(ctx, br.db).NewSelect().Model(nAccount).Where("account_uuid = ?", accID).Scan(ctx)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			br.logger.Debug().
+			br.logger.Debug().Ctx(ctx).
 				Str("account_uuid", accID.String()).
 				Msg("bank account not found")
 			return nil, domainsErrors.NotFoundError("bank account", accID.String())
 		}
 
-		br.logger.Error().Err(err).
+		br.logger.Error().Ctx(ctx).Err(err).
 			Str("account_uuid", accID.String()).
 			Msg("failed to get bank account")
 		return nil, domainsErrors.DatabaseError(err, "get bank account")
@@ -94,21 +151,32 @@ func (br *BankAccountRepository) GetByID(pCtx context.Context, accID uuid.UUID)
 	return nAccount, nil
 }
 
-func (br *BankAccountRepository) Update(pCtx context.Context, accUUID uuid.UUID, nAccount *domains.BankAccount) (*BankAccountModel, error) {
+// Update applies the fields selected by the account update. Closed accounts can't be updated and
+// a new overdraft limit is only accepted when the current balance stays within it.
+func (br *BankAccountRepository) Update(pCtx context.Context, accUUID uuid.UUID, update *domains.AccountUpdate) (*BankAccountModel, error) {
 	ctx, cancel := context.WithTimeout(pCtx, time.Second*5)
 	defer cancel()
 
-	nAccount.UpdatedAt = time.Now()
-	nBankAccountModel := NewBankAccountModel(nAccount)
-
-	result, err := br.db.NewUpdate().
+	nBankAccountModel := &BankAccountModel{}
+	query := dbConn(ctx, br.db).NewUpdate().
 		Model(nBankAccountModel).
-		Where("account_uuid = ? AND updated_at < ?", accUUID, nAccount.UpdatedAt).
-		Returning("*").
-		Exec(ctx)
+		Set("updated_at = ?", time.Now()).
+		Where("account_uuid = ?", accUUID).
+		Where("status <> ?", domains.AccountStatusClosed)
 
+	if update.AccountName != nil {
+		query = query.Set("account_name = ?", *update.AccountName)
+	}
+	if update.OverdraftLimit != nil {
+		nLimit := update.OverdraftLimit.Decimal()
+		query = query.Set("overdraft_limit = ?::numeric", nLimit).
+			Where("currency = ?", update.OverdraftLimit.Currency).
+			Where("current_balance >= -?::numeric", nLimit)
+	}
+
+	result, err := query.Returning("*").Exec(ctx, nBankAccountModel)
 	if err != nil {
-		br.logger.Error().Err(err).
+		br.logger.Error().Ctx(ctx).Err(err).
 			Str("account_uuid", accUUID.String()).
 			Msg("failed to update the bank account information")
 		return nil, domainsErrors.DatabaseError(err, "update bank account")
@@ -117,28 +185,162 @@ func (br *BankAccountRepository) Update(pCtx context.Context, accUUID uuid.UUID,
 	// Check if any rows were affected
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		br.logger.Error().Err(err).
+		br.logger.Error().Ctx(ctx).Err(err).
 			Str("account_uuid", accUUID.String()).
 			Msg("failed to get rows affected after update operation")
 		return nil, domainsErrors.DatabaseError(err, "check update result")
 	}
 
-	// If no rows were affected, either the account wasn't found or it was concurrently modified
+	// If no rows were affected, the account wasn't found, is closed or the new overdraft limit doesn't cover the balance
 	if rowsAffected == 0 {
-		// Check if the account exists
-		_, err := br.GetByID(pCtx, accUUID)
+		nAccount, err := br.GetByID(pCtx, accUUID)
 		if err != nil {
 			// Propagate the error (which should be NotFoundError if account doesn't exist)
 			return nil, err
 		}
+		if nAccount.Status == domains.AccountStatusClosed {
+			return nil, domainsErrors.AccountClosedError(accUUID.String())
+		}
+		if update.OverdraftLimit == nil {
+			// nothing else can reject a rename, the account changed between the update and the lookup
+			return nil, domainsErrors.ConcurrentModificationError("bank account", accUUID.String())
+		}
+		if nAccount.Currency != update.OverdraftLimit.Currency {
+			return nil, domainsErrors.InvalidCurrencyError(update.OverdraftLimit.Currency)
+		}
+
+		br.logger.Warn().Ctx(ctx).
+			Str("account_uuid", accUUID.String()).
+			Str("current_balance", nAccount.CurrentBalance).
+			Str("overdraft_limit", update.OverdraftLimit.String()).
+			Msg("new overdraft limit doesn't cover the bank account balance")
+		return nil, domainsErrors.InsufficientBalanceError(accUUID.String(), nAccount.CurrentBalance, update.OverdraftLimit.Neg().Decimal())
+	}
+
+	return nBankAccountModel, nil
+}
+
+// List returns up to limit accounts matching the filter, newest first.
+// When cursor is set only the accounts strictly after the cursor position are returned (keyset pagination).
+func (br *BankAccountRepository) List(pCtx context.Context, filter *domains.AccountFilter, cursor *domains.PageCursor, limit int) (BankAccountsModel, error) {
+	ctx, cancel := context.WithTimeout(pCtx, time.Second*5)
+	defer cancel()
+
+	accounts := make(BankAccountsModel, 0, limit)
+	query := dbConn(ctx, br.db).NewSelect().Model(&accounts)
+
+	if filter.Currency != "" {
+		query = query.Where("currency = ?", filter.Currency)
+	}
+	if filter.Name != "" {
+		query = query.Where("account_name ILIKE ?", "%"+escapeLike(filter.Name)+"%")
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	} else {
+		query = query.Where("status <> ?", domains.AccountStatusClosed)
+	}
+	if filter.OwnerSubject != "" {
+		query = query.Where("owner_subject = ?", filter.OwnerSubject)
+	}
+	if cursor != nil {
+		query = query.Where("(created_at, account_uuid) < (?, ?)", cursor.Timestamp, cursor.UUID)
+	}
+
+	err := query.
+		OrderExpr("created_at DESC, account_uuid DESC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		br.logger.Error().Ctx(ctx).Err(err).
+			Str("currency", filter.Currency).
+			Str("name", filter.Name).
+			Msg("failed to list bank accounts")
+		return nil, domainsErrors.DatabaseError(err, "list bank accounts")
+	}
+
+	return accounts, nil
+}
+
+// UpdateBalance adds amount (negative for debits) to the account balance with a single conditional update.
+// Debits are only applied when the resulting balance stays within the account overdraft limit, so concurrent debits can't overdraw the account.
+func (br *BankAccountRepository) UpdateBalance(pCtx context.Context, accUUID uuid.UUID, amount domains.Money) (*BankAccountModel, error) {
+	ctx, cancel := context.WithTimeout(pCtx, time.Second*5)
+	defer cancel()
+
+	// amounts travel as exact decimal strings so postgres does the arithmetic on numeric values
+	nAmount := amount.Decimal()
+	nBankAccountModel := &BankAccountModel{}
+	result, err := dbConn(ctx, br.db).NewUpdate().
+		Model(nBankAccountModel).
+		Set("current_balance = current_balance + ?::numeric", nAmount).
+		Set("updated_at = ?", time.Now()).
+		Where("account_uuid = ?", accUUID).
+		Where("currency = ?", amount.Currency).
+		Where("(?::numeric >= 0 OR current_balance + ?::numeric >= -overdraft_limit)", nAmount, nAmount).
+		Where("(status = ? OR (status = ? AND ?::numeric >= 0))", domains.AccountStatusActive, domains.AccountStatusFrozen, nAmount).
+		Returning("*").
+		Exec(ctx, nBankAccountModel)
+
+	if err != nil {
+		br.logger.Error().Ctx(ctx).Err(err).
+			Str("account_uuid", accUUID.String()).
+			Str("amount", amount.String()).
+			Msg("failed to update the bank account balance")
+		return nil, domainsErrors.DatabaseError(err, "update bank account balance")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		br.logger.Error().Ctx(ctx).Err(err).
+			Str("account_uuid", accUUID.String()).
+			Msg("failed to get rows affected after balance update operation")
+		return nil, domainsErrors.DatabaseError(err, "check balance update result")
+	}
+
+	// If no rows were affected, either the account wasn't found, isn't open for the movement, the currency doesn't match or the debit exceeds the balance plus overdraft limit
+	if rowsAffected == 0 {
+		nAccount, err := br.GetByID(pCtx, accUUID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case nAccount.Status == domains.AccountStatusClosed:
+			br.logger.Warn().Ctx(ctx).
+				Str("account_uuid", accUUID.String()).
+				Msg("balance update on a closed bank account")
+			return nil, domainsErrors.AccountClosedError(accUUID.String())
+		case nAccount.Status == domains.AccountStatusFrozen && amount.IsNegative():
+			br.logger.Warn().Ctx(ctx).
+				Str("account_uuid", accUUID.String()).
+				Str("amount", amount.String()).
+				Msg("debit on a frozen bank account")
+			return nil, domainsErrors.AccountFrozenError(accUUID.String())
+		}
+
+		if nAccount.Currency != amount.Currency {
+			br.logger.Warn().Ctx(ctx).
+				Str("account_uuid", accUUID.String()).
+				Str("account_currency", nAccount.Currency).
+				Str("currency", amount.Currency).
+				Msg("balance update currency doesn't match the bank account currency")
+			return nil, domainsErrors.InvalidCurrencyError(amount.Currency)
+		}
 
-		// If we got here, the account exists but was concurrently modified
-		br.logger.Warn().
+		br.logger.Warn().Ctx(ctx).
 			Str("account_uuid", accUUID.String()).
-			Time("updated_at", nAccount.UpdatedAt).
-			Msg("concurrent modification detected on bank account")
-		return nil, domainsErrors.ConcurrentModificationError("bank account", accUUID.String())
+			Str("current_balance", nAccount.CurrentBalance).
+			Str("overdraft_limit", nAccount.OverdraftLimit).
+			Str("amount", amount.String()).
+			Msg("insufficient balance on bank account")
+		return nil, domainsErrors.InsufficientBalanceError(accUUID.String(), nAccount.CurrentBalance, amount.Neg().Decimal())
 	}
 
 	return nBankAccountModel, nil
 }
+
+// escapeLike escapes the LIKE wildcards so user input is matched literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
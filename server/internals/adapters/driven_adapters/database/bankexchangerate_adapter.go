@@ -23,19 +23,20 @@ func NewBankExchangeRateRepository(db *bun.DB, logger *zerolog.Logger) *BankExch
 	}
 }
 
+// GetAll returns the stored rates. The rows of the opposite direction of a pair, kept for their history, are left out.
 func (ad *BankExchangeRateRepository) GetAll(pCtx context.Context) (ExchangeRatesModel, error) {
 	exchList := &ExchangeRatesModel{}
 	ctx, cancel := context.WithTimeout(pCtx, time.Second*5)
 	defer cancel()
 
-	count, err := ad.db.NewSelect().Model(exchList).ScanAndCount(ctx)
+	count, err := dbConn(ctx, ad.db).NewSelect().Model(exchList).Where("from_currency < to_currency").ScanAndCount(ctx)
 	if err != nil {
-		ad.logger.Error().Err(err).Msg("failed to get all exchange rates")
+		ad.logger.Error().Ctx(ctx).Err(err).Msg("failed to get all exchange rates")
 		return nil, domainsErrors.DatabaseError(err, "get all exchange rates")
 	}
 
 	if count == 0 {
-		ad.logger.Debug().Msg("no exchange rates found")
+		ad.logger.Debug().Ctx(ctx).Msg("no exchange rates found")
 		return *exchList, nil // Return empty list, not an error
 	}
 
@@ -47,16 +48,16 @@ func (ad *BankExchangeRateRepository) GetByID(pCtx context.Context, exchUUID uui
 	defer cancel()
 
 	nEx := &ExchangeRateModel{}
-	err := ad.db.NewSelect().Model(nEx).Where("exchange_rate_uuid = ?", exchUUID).Scan(ctx)
+	err := dbConn(ctx, ad.db).NewSelect().Model(nEx).Where("exchange_rate_uuid = ?", exchUUID).Scan(ctx)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			ad.logger.Debug().
+			ad.logger.Debug().Ctx(ctx).
 				Str("exchange_rate_uuid", exchUUID.String()).
 				Msg("exchange rate not found")
 			return nil, domainsErrors.NotFoundError("exchange rate", exchUUID.String())
 		}
 
-		ad.logger.Error().Err(err).
+		ad.logger.Error().Ctx(ctx).Err(err).
 			Str("exchange_rate_uuid", exchUUID.String()).
 			Msg("failed to get exchange rate")
 		return nil, domainsErrors.DatabaseError(err, "get exchange rate by ID")
@@ -65,12 +66,18 @@ func (ad *BankExchangeRateRepository) GetByID(pCtx context.Context, exchUUID uui
 	return nEx, nil
 }
 
+// GetByCurrencies returns the rate of the pair valid right now, or a stale exchange rate error when the latest rate has expired
 func (ad *BankExchangeRateRepository) GetByCurrencies(pCtx context.Context, FromCurrency string, ToCurrency string) (*ExchangeRateModel, error) {
+	return ad.GetAsOf(pCtx, FromCurrency, ToCurrency, time.Now())
+}
+
+// GetLatest returns the latest rate of the pair whether or not it's still valid
+func (ad *BankExchangeRateRepository) GetLatest(pCtx context.Context, FromCurrency string, ToCurrency string) (*ExchangeRateModel, error) {
 	if FromCurrency == ToCurrency {
 		return &ExchangeRateModel{
 			FromCurrency: FromCurrency,
 			ToCurrency:   ToCurrency,
-			Rate:         1,
+			Rate:         "1",
 		}, nil
 	}
 
@@ -78,17 +85,17 @@ func (ad *BankExchangeRateRepository) GetByCurrencies(pCtx context.Context, From
 	defer cancel()
 
 	nEx := &ExchangeRateModel{}
-	err := ad.db.NewSelect().Model(nEx).Where("from_currency = ? and to_currency = ?", FromCurrency, ToCurrency).Scan(ctx)
+	err := dbConn(ctx, ad.db).NewSelect().Model(nEx).Where("from_currency = ? and to_currency = ?", FromCurrency, ToCurrency).Scan(ctx)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			ad.logger.Debug().
+			ad.logger.Debug().Ctx(ctx).
 				Str("from_currency", FromCurrency).
 				Str("to_currency", ToCurrency).
 				Msg("exchange rate not found for currency pair")
 			return nil, domainsErrors.NotFoundError("exchange rate", FromCurrency+"/"+ToCurrency)
 		}
 
-		ad.logger.Error().Err(err).
+		ad.logger.Error().Ctx(ctx).Err(err).
 			Str("from_currency", FromCurrency).
 			Str("to_currency", ToCurrency).
 			Msg("failed to get exchange rate for currency pair")
@@ -98,20 +105,157 @@ func (ad *BankExchangeRateRepository) GetByCurrencies(pCtx context.Context, From
 	return nEx, nil
 }
 
+// GetAsOf returns the rate the pair had at the given time from the rate history.
+// A rate whose validity window ended before that time is reported as a stale exchange rate error.
+func (ad *BankExchangeRateRepository) GetAsOf(pCtx context.Context, FromCurrency string, ToCurrency string, at time.Time) (*ExchangeRateModel, error) {
+	if FromCurrency == ToCurrency {
+		return &ExchangeRateModel{
+			FromCurrency: FromCurrency,
+			ToCurrency:   ToCurrency,
+			Rate:         "1",
+		}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(pCtx, time.Second*5)
+	defer cancel()
+
+	entry := &ExchangeRateHistoryModel{}
+	err := dbConn(ctx, ad.db).NewSelect().Model(entry).
+		Where("from_currency = ? AND to_currency = ? AND valid_from_timestamp <= ?", FromCurrency, ToCurrency, at).
+		Order("valid_from_timestamp DESC", "sequence DESC").
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ad.logger.Debug().Ctx(ctx).
+				Str("from_currency", FromCurrency).
+				Str("to_currency", ToCurrency).
+				Time("as_of", at).
+				Msg("exchange rate not found for currency pair")
+			return nil, domainsErrors.NotFoundError("exchange rate", FromCurrency+"/"+ToCurrency)
+		}
+
+		ad.logger.Error().Ctx(ctx).Err(err).
+			Str("from_currency", FromCurrency).
+			Str("to_currency", ToCurrency).
+			Time("as_of", at).
+			Msg("failed to get exchange rate for currency pair")
+		return nil, domainsErrors.DatabaseError(err, "get exchange rate as of time")
+	}
+
+	if !at.Before(entry.ValidToTimestamp) {
+		ad.logger.Warn().Ctx(ctx).
+			Str("from_currency", FromCurrency).
+			Str("to_currency", ToCurrency).
+			Time("as_of", at).
+			Time("valid_to", entry.ValidToTimestamp).
+			Msg("exchange rate is stale")
+		return nil, domainsErrors.StaleExchangeRateError(FromCurrency+"/"+ToCurrency, entry.ValidToTimestamp, at)
+	}
+	return entry.ToExchangeRate(), nil
+}
+
+// GetHistory aggregates the rate changes of the pair which took effect between start and end in buckets of the given interval.
+// It also returns the rate in effect at start, nil when the pair had no rate yet. Buckets without any change are omitted.
+func (ad *BankExchangeRateRepository) GetHistory(pCtx context.Context, FromCurrency string, ToCurrency string, start time.Time, end time.Time, interval time.Duration) (*ExchangeRateHistoryModel, []ExchangeRateBucketModel, error) {
+	ctx, cancel := context.WithTimeout(pCtx, time.Second*10)
+	defer cancel()
+
+	opening := &ExchangeRateHistoryModel{}
+	err := dbConn(ctx, ad.db).NewSelect().Model(opening).
+		Where("from_currency = ? AND to_currency = ? AND valid_from_timestamp < ?", FromCurrency, ToCurrency, start).
+		Order("valid_from_timestamp DESC", "sequence DESC").
+		Limit(1).
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		opening = nil
+	} else if err != nil {
+		ad.logger.Error().Ctx(ctx).Err(err).
+			Str("from_currency", FromCurrency).
+			Str("to_currency", ToCurrency).
+			Msg("failed to get opening exchange rate of the history")
+		return nil, nil, domainsErrors.DatabaseError(err, "get exchange rate history")
+	}
+
+	buckets := []ExchangeRateBucketModel{}
+	err = dbConn(ctx, ad.db).NewRaw(`
+		SELECT floor(extract(epoch FROM valid_from_timestamp - ?::timestamptz) / ?)::bigint AS bucket,
+			(array_agg(rate ORDER BY valid_from_timestamp, sequence))[1]::text AS open,
+			max(rate)::text AS high,
+			min(rate)::text AS low,
+			(array_agg(rate ORDER BY valid_from_timestamp DESC, sequence DESC))[1]::text AS close,
+			count(*) AS changes
+		FROM bank_exchange_rate_history
+		WHERE from_currency = ? AND to_currency = ? AND valid_from_timestamp >= ? AND valid_from_timestamp < ?
+		GROUP BY bucket
+		ORDER BY bucket`,
+		start, interval.Seconds(), FromCurrency, ToCurrency, start, end).
+		Scan(ctx, &buckets)
+	if err != nil && err != sql.ErrNoRows {
+		ad.logger.Error().Ctx(ctx).Err(err).
+			Str("from_currency", FromCurrency).
+			Str("to_currency", ToCurrency).
+			Time("start_time", start).
+			Time("end_time", end).
+			Msg("failed to aggregate exchange rate history")
+		return nil, nil, domainsErrors.DatabaseError(err, "get exchange rate history")
+	}
+
+	return opening, buckets, nil
+}
+
+// SaveRate writes the current rate of the pair, creating the pair when it doesn't exist yet.
+// A pair is stored from the alphabetically first currency to the second one, the database rejects the opposite direction.
+func (ad *BankExchangeRateRepository) SaveRate(pCtx context.Context, FromCurrency string, ToCurrency string, rate string, validFrom time.Time, validTo time.Time) (*ExchangeRateModel, error) {
+	ctx, cancel := context.WithTimeout(pCtx, time.Second*5)
+	defer cancel()
+
+	now := time.Now()
+	nEx := &ExchangeRateModel{
+		ExchangeRateUUID:   uuid.New(),
+		FromCurrency:       FromCurrency,
+		ToCurrency:         ToCurrency,
+		Rate:               rate,
+		ValidFromTimestamp: validFrom,
+		ValidToTimestamp:   validTo,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+	_, err := dbConn(ctx, ad.db).NewInsert().
+		Model(nEx).
+		On("CONFLICT (from_currency, to_currency) DO UPDATE").
+		Set("rate = EXCLUDED.rate").
+		Set("valid_from_timestamp = EXCLUDED.valid_from_timestamp").
+		Set("valid_to_timestamp = EXCLUDED.valid_to_timestamp").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		ad.logger.Error().Ctx(ctx).Err(err).
+			Str("from_currency", FromCurrency).
+			Str("to_currency", ToCurrency).
+			Str("rate", rate).
+			Msg("failed to save exchange rate")
+		return nil, domainsErrors.DatabaseError(err, "save exchange rate")
+	}
+
+	return nEx, nil
+}
+
 func (ad *BankExchangeRateRepository) Update(pCtx context.Context, exchUUID uuid.UUID, nExchangeRate *ExchangeRateModel) (*ExchangeRateModel, error) {
 	ctx, cancel := context.WithTimeout(pCtx, time.Second*5)
 	defer cancel()
 
 	nExchangeRate.UpdatedAt = time.Now()
 
-	result, err := ad.db.NewUpdate().
+	result, err := dbConn(ctx, ad.db).NewUpdate().
 		Model(nExchangeRate).
 		Where("exchange_rate_uuid = ? and updated_at < ? ", exchUUID, nExchangeRate.UpdatedAt).
 		Returning("*").
 		Exec(ctx)
 
 	if err != nil {
-		ad.logger.Error().Err(err).
+		ad.logger.Error().Ctx(ctx).Err(err).
 			Str("exchange_rate_uuid", exchUUID.String()).
 			Msg("failed to update exchange rate")
 		return nil, domainsErrors.DatabaseError(err, "update exchange rate")
@@ -120,7 +264,7 @@ func (ad *BankExchangeRateRepository) Update(pCtx context.Context, exchUUID uuid
 	// Check if any rows were affected
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		ad.logger.Error().Err(err).
+		ad.logger.Error().Ctx(ctx).Err(err).
 			Str("exchange_rate_uuid", exchUUID.String()).
 			Msg("failed to get rows affected after update operation")
 		return nil, domainsErrors.DatabaseError(err, "check update result")
@@ -136,7 +280,7 @@ func (ad *BankExchangeRateRepository) Update(pCtx context.Context, exchUUID uuid
 		}
 
 		// If we got here, the exchange rate exists but was concurrently modified
-		ad.logger.Warn().
+		ad.logger.Warn().Ctx(ctx).
 			Str("exchange_rate_uuid", exchUUID.String()).
 			Time("updated_at", nExchangeRate.UpdatedAt).
 			Msg("concurrent modification detected on exchange rate")
@@ -0,0 +1,136 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+// ExchangeRateChangedChannel is the postgres channel notified by the bank_exchange_rates triggers on every rate change
+const ExchangeRateChangedChannel = "bank_exchange_rate_changed"
+
+// the listener wakes up at least this often to notice the end of its context
+const exchangeRateListenerIdle = 15 * time.Second
+
+// time between two attempts to reach the database when the listener connection is down
+const exchangeRateListenerRetry = time.Second
+
+// BankExchangeRateListener receives the exchange rate changes written by any replica through postgres LISTEN/NOTIFY
+type BankExchangeRateListener struct {
+	db     *bun.DB
+	repo   *BankExchangeRateRepository
+	logger *zerolog.Logger
+}
+
+func NewBankExchangeRateListener(db *bun.DB, logger *zerolog.Logger) *BankExchangeRateListener {
+	return &BankExchangeRateListener{
+		db:     db,
+		repo:   NewBankExchangeRateRepository(db, logger),
+		logger: logger,
+	}
+}
+
+// exchangeRateNotification is the payload built by the bank_exchange_rates_notify trigger
+type exchangeRateNotification struct {
+	ExchangeRateUUID   uuid.UUID `json:"exchange_rate_uuid"`
+	FromCurrency       string    `json:"from_currency"`
+	ToCurrency         string    `json:"to_currency"`
+	Rate               string    `json:"rate"`
+	ValidFromTimestamp time.Time `json:"valid_from_timestamp"`
+	ValidToTimestamp   time.Time `json:"valid_to_timestamp"`
+	Sequence           int64     `json:"sequence"`
+}
+
+// Listen calls onChange with every exchange rate change until the context is done.
+// The notifications sent while the connection is down are lost, so all the rates are read and passed to onChange
+// when the listener starts and after every reconnection. onChange has to ignore the sequences it has already seen.
+// A database unreachable at startup is retried like a lost connection, Listen only returns once the context is done.
+func (ad *BankExchangeRateListener) Listen(ctx context.Context, onChange func(context.Context, *ExchangeRateModel)) error {
+	ln := pgdriver.NewListener(ad.db)
+	defer ln.Close()
+
+	for {
+		err := ln.Listen(ctx, ExchangeRateChangedChannel)
+		if err == nil {
+			break
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+		ad.logger.Warn().Err(err).Msg("failed to listen for exchange rate changes, retrying")
+		if !waitListenerRetry(ctx) {
+			return nil
+		}
+	}
+	ad.logger.Info().Str("channel", ExchangeRateChangedChannel).Msg("listening for exchange rate changes")
+
+	resync := true
+	for {
+		if resync {
+			if err := ad.resync(ctx, onChange); err == nil {
+				resync = false
+			}
+		}
+
+		_, payload, err := ln.ReceiveTimeout(ctx, exchangeRateListenerIdle)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				continue
+			}
+			// the listener reconnects and listens again on the next receive
+			ad.logger.Warn().Err(err).Msg("exchange rate listener connection lost, reconnecting")
+			resync = true
+			if !waitListenerRetry(ctx) {
+				return nil
+			}
+			continue
+		}
+
+		n := exchangeRateNotification{}
+		if err := json.Unmarshal([]byte(payload), &n); err != nil {
+			ad.logger.Error().Err(err).Str("payload", payload).Msg("failed to decode exchange rate notification")
+			continue
+		}
+		onChange(ctx, &ExchangeRateModel{
+			ExchangeRateUUID:   n.ExchangeRateUUID,
+			FromCurrency:       n.FromCurrency,
+			ToCurrency:         n.ToCurrency,
+			Rate:               n.Rate,
+			ValidFromTimestamp: n.ValidFromTimestamp,
+			ValidToTimestamp:   n.ValidToTimestamp,
+			Sequence:           n.Sequence,
+		})
+	}
+}
+
+// waitListenerRetry waits before the next attempt to reach the database and reports false once the context is done
+func waitListenerRetry(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(exchangeRateListenerRetry):
+		return true
+	}
+}
+
+func (ad *BankExchangeRateListener) resync(ctx context.Context, onChange func(context.Context, *ExchangeRateModel)) error {
+	exRates, err := ad.repo.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range exRates {
+		onChange(ctx, &exRates[i])
+	}
+	return nil
+}
@@ -30,12 +30,12 @@ func (br *BankTransactionRepository) CreateTransaction(pCtx context.Context, bt
 
 	nTransactionModel := NewBankTransactionModel(bt)
 
-	_, err := br.db.NewInsert().Model(nTransactionModel).Exec(ctx, nTransactionModel)
+	_, err := dbConn(ctx, br.db).NewInsert().Model(nTransactionModel).Exec(ctx, nTransactionModel)
 	if err != nil {
-		br.logger.Error().Err(err).
+		br.logger.Error().Ctx(ctx).Err(err).
 			Str("transaction_uuid", bt.TransactionUUID.String()).
 			Str("account_uuid", bt.AccountUUID.String()).
-			Float64("amount", bt.Amount).
+			Str("amount", bt.Amount.String()).
 			Str("transaction_type", bt.TransactionType).
 			Msg("failed to create transaction")
 		return nil, domainsErrors.DatabaseError(err, "create bank transaction")
@@ -48,16 +48,16 @@ func (br *BankTransactionRepository) GetTransactionByID(pCtx context.Context, tr
 	defer cancel()
 
 	transaction := &BankTransactionModel{}
-	err := br.db.NewSelect().Model(transaction).Where("transaction_uuid = ?", transactionUUID).Scan(ctx)
+	err := dbConn(ctx, br.db).NewSelect().Model(transaction).Where("transaction_uuid = ?", transactionUUID).Scan(ctx)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			br.logger.Debug().
+			br.logger.Debug().Ctx(ctx).
 				Str("transaction_uuid", transactionUUID.String()).
 				Msg("transaction not found")
 			return nil, domainsErrors.NotFoundError("bank transaction", transactionUUID.String())
 		}
 
-		br.logger.Error().Err(err).
+		br.logger.Error().Ctx(ctx).Err(err).
 			Str("transaction_uuid", transactionUUID.String()).
 			Msg("failed to get transaction")
 		return nil, domainsErrors.DatabaseError(err, "get bank transaction")
@@ -71,19 +71,73 @@ func (br *BankTransactionRepository) GetTransactionsByAccount(pCtx context.Conte
 	defer cancel()
 
 	transactions := make(BankTransactionsModel, 0)
-	err := br.db.NewSelect().Model(&transactions).Where("account_uuid = ?", accountUUID).Scan(ctx)
+	err := dbConn(ctx, br.db).NewSelect().Model(&transactions).Where("account_uuid = ?", accountUUID).Scan(ctx)
 	if err != nil {
-		br.logger.Error().Err(err).
+		br.logger.Error().Ctx(ctx).Err(err).
 			Str("account_uuid", accountUUID.String()).
 			Msg("failed to get transactions for account")
 		return nil, domainsErrors.DatabaseError(err, "get transactions by account")
 	}
 
 	if len(transactions) == 0 {
-		br.logger.Debug().
+		br.logger.Debug().Ctx(ctx).
 			Str("account_uuid", accountUUID.String()).
 			Msg("no transactions found for account")
 	}
 
 	return transactions, nil
 }
+
+// ListTransactions returns up to limit transactions matching the filter, newest first.
+// When cursor is set only the transactions strictly after the cursor position are returned (keyset pagination).
+func (br *BankTransactionRepository) ListTransactions(pCtx context.Context, filter *domains.TransactionFilter, cursor *domains.PageCursor, limit int) (BankTransactionsModel, error) {
+	ctx, cancel := context.WithTimeout(pCtx, time.Second*5)
+	defer cancel()
+
+	transactions := make(BankTransactionsModel, 0, limit)
+	query := dbConn(ctx, br.db).NewSelect().Model(&transactions)
+
+	if filter.AccountUUID != uuid.Nil {
+		query = query.Where("account_uuid = ?", filter.AccountUUID)
+	}
+	if filter.OwnerSubject != "" {
+		query = query.Where("account_uuid IN (?)", dbConn(ctx, br.db).NewSelect().
+			Model((*BankAccountModel)(nil)).
+			Column("account_uuid").
+			Where("owner_subject = ?", filter.OwnerSubject))
+	}
+	if filter.TransactionType != "" {
+		query = query.Where("transaction_type = ?", filter.TransactionType)
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("transaction_timestamp >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("transaction_timestamp < ?", filter.To)
+	}
+	if filter.MinAmount != nil {
+		query = query.Where("currency = ?", filter.MinAmount.Currency).
+			Where("amount >= ?::numeric", filter.MinAmount.Decimal())
+	}
+	if filter.MaxAmount != nil {
+		query = query.Where("currency = ?", filter.MaxAmount.Currency).
+			Where("amount <= ?::numeric", filter.MaxAmount.Decimal())
+	}
+	if cursor != nil {
+		query = query.Where("(transaction_timestamp, transaction_uuid) < (?, ?)", cursor.Timestamp, cursor.UUID)
+	}
+
+	err := query.
+		OrderExpr("transaction_timestamp DESC, transaction_uuid DESC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		br.logger.Error().Ctx(ctx).Err(err).
+			Str("account_uuid", filter.AccountUUID.String()).
+			Str("transaction_type", filter.TransactionType).
+			Msg("failed to list transactions")
+		return nil, domainsErrors.DatabaseError(err, "list bank transactions")
+	}
+
+	return transactions, nil
+}
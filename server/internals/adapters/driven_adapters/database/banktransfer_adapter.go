@@ -24,22 +24,32 @@ func NewBankTransferRepository(db *bun.DB, logger *zerolog.Logger) *BankTransfer
 	}
 }
 
+// CreateTransfer stores a new transfer along with the first entry of its status history.
+// It should run inside a unit of work so the transfer never exists without its history.
 func (ad *BankTransferRepository) CreateTransfer(pCtx context.Context, ntransfer *domains.BankTransfer) (*BankTransferModel, error) {
 	ctx, cancel := context.WithTimeout(pCtx, time.Second*5)
 	defer cancel()
 
 	ntransferModel := NewTransferModel(ntransfer)
-	_, err := ad.db.NewInsert().Model(ntransferModel).Exec(ctx, ntransferModel)
+	_, err := dbConn(ctx, ad.db).NewInsert().Model(ntransferModel).Exec(ctx, ntransferModel)
 	if err != nil {
-		ad.logger.Error().Err(err).
+		ad.logger.Error().Ctx(ctx).Err(err).
 			Str("src_account", ntransfer.FromAccountUUID.String()).
 			Str("to_account", ntransfer.ToAccountUUID.String()).
-			Float64("amount", ntransfer.Amount).
+			Str("amount", ntransfer.Amount.String()).
 			Time("transfer_timestamp", ntransfer.TransferTimestamp).
 			Msg("failed creating new transfer object in database")
 
 		return nil, domainsErrors.DatabaseError(err, "create bank transfer")
 	}
+
+	err = ad.addStatusHistory(ctx, ntransfer.TransferUUID, &domains.TransferStatusChange{
+		ToStatus:  ntransfer.Status,
+		ChangedAt: ntransfer.CreatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
 	return ntransferModel, nil
 }
 
@@ -48,16 +58,16 @@ func (ad *BankTransferRepository) GetTransferByID(pCtx context.Context, transfer
 	defer cancel()
 
 	transfer := &BankTransferModel{}
-	err := ad.db.NewSelect().Model(transfer).Where("transfer_uuid = ?", transferUUID).Scan(ctx)
+	err := dbConn(ctx, ad.db).NewSelect().Model(transfer).Where("transfer_uuid = ?", transferUUID).Scan(ctx)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			ad.logger.Debug().
+			ad.logger.Debug().Ctx(ctx).
 				Str("transfer_uuid", transferUUID.String()).
 				Msg("bank transfer not found")
 			return nil, domainsErrors.NotFoundError("bank transfer", transferUUID.String())
 		}
 
-		ad.logger.Error().Err(err).
+		ad.logger.Error().Ctx(ctx).Err(err).
 			Str("transfer_uuid", transferUUID.String()).
 			Msg("failed to get bank transfer")
 		return nil, domainsErrors.DatabaseError(err, "get bank transfer")
@@ -66,55 +76,141 @@ func (ad *BankTransferRepository) GetTransferByID(pCtx context.Context, transfer
 	return transfer, nil
 }
 
-func (ad *BankTransferRepository) UpdateTransfer(pCtx context.Context, transferUUID uuid.UUID, ntransfer *domains.BankTransfer) (*BankTransferModel, error) {
+// UpdateTransferStatus persists a status change of the transfer produced by BankTransfer.TransitionTo and appends it to the status history.
+// The update only applies while the stored status still is the status the change starts from, so two concurrent
+// changes of the same transfer can't both succeed. It should run inside a unit of work.
+func (ad *BankTransferRepository) UpdateTransferStatus(pCtx context.Context, ntransfer *domains.BankTransfer, change *domains.TransferStatusChange) (*BankTransferModel, error) {
 	ctx, cancel := context.WithTimeout(pCtx, time.Second*5)
 	defer cancel()
 
 	ntransferModel := NewTransferModel(ntransfer)
-	ntransferModel.UpdatedAt = time.Now()
-
-	result, err := ad.db.NewUpdate().
+	result, err := dbConn(ctx, ad.db).NewUpdate().
 		Model(ntransferModel).
-		Where("transfer_uuid = ? AND updated_at < ?", transferUUID, ntransferModel.UpdatedAt).
+		Column("status", "failure_reason", "debit_currency", "debit_amount", "updated_at").
+		Where("transfer_uuid = ? AND status = ?", ntransfer.TransferUUID, change.FromStatus).
 		Returning("*").
 		Exec(ctx)
-
 	if err != nil {
-		ad.logger.Error().Err(err).
-			Str("src_account", ntransfer.FromAccountUUID.String()).
-			Str("to_account", ntransfer.ToAccountUUID.String()).
-			Float64("amount", ntransfer.Amount).
-			Time("transfer_timestamp", ntransfer.TransferTimestamp).
-			Msg("failed updating transfer object in database")
-
-		return nil, domainsErrors.DatabaseError(err, "update bank transfer")
+		ad.logger.Error().Ctx(ctx).Err(err).
+			Str("transfer_uuid", ntransfer.TransferUUID.String()).
+			Str("from_status", change.FromStatus).
+			Str("to_status", change.ToStatus).
+			Msg("failed updating transfer status in database")
+		return nil, domainsErrors.DatabaseError(err, "update bank transfer status")
 	}
 
-	// Check if any rows were affected
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		ad.logger.Error().Err(err).
-			Str("transfer_uuid", transferUUID.String()).
+		ad.logger.Error().Ctx(ctx).Err(err).
+			Str("transfer_uuid", ntransfer.TransferUUID.String()).
 			Msg("failed to get rows affected after update operation")
 		return nil, domainsErrors.DatabaseError(err, "check update result")
 	}
 
-	// If no rows were affected, either the transfer wasn't found or it was concurrently modified
+	// If no rows were affected, either the transfer wasn't found or its status has been changed by someone else
 	if rowsAffected == 0 {
-		// Check if the transfer exists
-		_, err := ad.GetTransferByID(pCtx, transferUUID)
+		current, err := ad.GetTransferByID(pCtx, ntransfer.TransferUUID)
 		if err != nil {
-			// Propagate the error (which should be NotFoundError if transfer doesn't exist)
 			return nil, err
 		}
 
-		// If we got here, the transfer exists but was concurrently modified
-		ad.logger.Warn().
-			Str("transfer_uuid", transferUUID.String()).
-			Time("updated_at", ntransfer.UpdatedAt).
-			Msg("concurrent modification detected on bank transfer")
-		return nil, domainsErrors.ConcurrentModificationError("bank transfer", transferUUID.String())
+		ad.logger.Warn().Ctx(ctx).
+			Str("transfer_uuid", ntransfer.TransferUUID.String()).
+			Str("expected_status", change.FromStatus).
+			Str("current_status", current.Status).
+			Msg("concurrent status change detected on bank transfer")
+		return nil, domainsErrors.InvalidStatusTransitionError("bank transfer", ntransfer.TransferUUID.String(), current.Status, change.ToStatus)
 	}
 
+	if err := ad.addStatusHistory(ctx, ntransfer.TransferUUID, change); err != nil {
+		return nil, err
+	}
 	return ntransferModel, nil
 }
+
+// GetTransferHistory returns the status history of a transfer, oldest change first
+func (ad *BankTransferRepository) GetTransferHistory(pCtx context.Context, transferUUID uuid.UUID) (TransferStatusHistoryModels, error) {
+	ctx, cancel := context.WithTimeout(pCtx, time.Second*5)
+	defer cancel()
+
+	history := make(TransferStatusHistoryModels, 0)
+	err := dbConn(ctx, ad.db).NewSelect().
+		Model(&history).
+		Where("transfer_uuid = ?", transferUUID).
+		OrderExpr("changed_at ASC").
+		Scan(ctx)
+	if err != nil {
+		ad.logger.Error().Ctx(ctx).Err(err).
+			Str("transfer_uuid", transferUUID.String()).
+			Msg("failed to get bank transfer status history")
+		return nil, domainsErrors.DatabaseError(err, "get bank transfer status history")
+	}
+	return history, nil
+}
+
+// ListTransfers returns up to limit transfers matching the filter, newest first.
+// When cursor is set only the transfers strictly after the cursor position are returned (keyset pagination).
+func (ad *BankTransferRepository) ListTransfers(pCtx context.Context, filter *domains.TransferFilter, cursor *domains.PageCursor, limit int) (BankTransfersModel, error) {
+	ctx, cancel := context.WithTimeout(pCtx, time.Second*5)
+	defer cancel()
+
+	transfers := make(BankTransfersModel, 0, limit)
+	query := dbConn(ctx, ad.db).NewSelect().Model(&transfers)
+
+	if filter.AccountUUID != uuid.Nil {
+		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("from_account_uuid = ?", filter.AccountUUID).
+				WhereOr("to_account_uuid = ?", filter.AccountUUID)
+		})
+	}
+	if filter.OwnerSubject != "" {
+		owned := dbConn(ctx, ad.db).NewSelect().
+			Model((*BankAccountModel)(nil)).
+			Column("account_uuid").
+			Where("owner_subject = ?", filter.OwnerSubject)
+		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("from_account_uuid IN (?)", owned).
+				WhereOr("to_account_uuid IN (?)", owned)
+		})
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("transfer_timestamp >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("transfer_timestamp < ?", filter.To)
+	}
+	if cursor != nil {
+		query = query.Where("(transfer_timestamp, transfer_uuid) < (?, ?)", cursor.Timestamp, cursor.UUID)
+	}
+
+	err := query.
+		OrderExpr("transfer_timestamp DESC, transfer_uuid DESC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		ad.logger.Error().Ctx(ctx).Err(err).
+			Str("account_uuid", filter.AccountUUID.String()).
+			Str("status", filter.Status).
+			Msg("failed to list transfers")
+		return nil, domainsErrors.DatabaseError(err, "list bank transfers")
+	}
+
+	return transfers, nil
+}
+
+func (ad *BankTransferRepository) addStatusHistory(ctx context.Context, transferUUID uuid.UUID, change *domains.TransferStatusChange) error {
+	_, err := dbConn(ctx, ad.db).NewInsert().
+		Model(NewTransferStatusHistoryModel(transferUUID, change)).
+		Exec(ctx)
+	if err != nil {
+		ad.logger.Error().Ctx(ctx).Err(err).
+			Str("transfer_uuid", transferUUID.String()).
+			Str("to_status", change.ToStatus).
+			Msg("failed to record bank transfer status change")
+		return domainsErrors.DatabaseError(err, "record bank transfer status change")
+	}
+	return nil
+}
@@ -27,6 +27,9 @@ func NewBunDB(ctx context.Context, cfg *DbConfig) (*bun.DB, error) {
 		otelsql.WithAttributes(semconv.DBSystemPostgreSQL),
 	)
 
+	// export the connection pool stats, e.g. go_sql_connections_in_use and go_sql_connections_wait_duration
+	otelsql.ReportDBStatsMetrics(sqldb, otelsql.WithAttributes(semconv.DBSystemPostgreSQL))
+
 	db := bun.NewDB(sqldb, pgdialect.New(), bun.WithDiscardUnknownColumns())
 	db.AddQueryHook(bunzerolog.NewQueryHook(
 		bunzerolog.WithLogger(cfg.Logger),
@@ -0,0 +1,33 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	domainsErrors "github.com/cybrarymin/gRPC/server/internals/domains/errors"
+	"github.com/rs/zerolog"
+	"github.com/uptrace/bun"
+)
+
+type HealthRepository struct {
+	db     *bun.DB
+	logger *zerolog.Logger
+}
+
+func NewHealthRepository(db *bun.DB, logger *zerolog.Logger) *HealthRepository {
+	return &HealthRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Ping checks a connection of the pool can reach the database
+func (hr *HealthRepository) Ping(pCtx context.Context) error {
+	ctx, cancel := context.WithTimeout(pCtx, time.Second*2)
+	defer cancel()
+
+	if err := hr.db.PingContext(ctx); err != nil {
+		return domainsErrors.DatabaseError(err, "ping database")
+	}
+	return nil
+}
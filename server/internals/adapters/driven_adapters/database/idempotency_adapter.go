@@ -0,0 +1,109 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	domainsErrors "github.com/cybrarymin/gRPC/server/internals/domains/errors"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/uptrace/bun"
+)
+
+type IdempotencyKeyRepository struct {
+	db     *bun.DB
+	logger *zerolog.Logger
+}
+
+func NewIdempotencyKeyRepository(db *bun.DB, logger *zerolog.Logger) *IdempotencyKeyRepository {
+	return &IdempotencyKeyRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// ReserveIdempotencyKey stores the idempotency key if it hasn't been used before and reports true.
+// If the key already exists the stored record is returned with false.
+// Keys are scoped by principal, only the requests of the same principal share a key.
+// Inside a transaction a concurrent request with the same key blocks on the primary key until the first one commits or rolls back.
+func (ir *IdempotencyKeyRepository) ReserveIdempotencyKey(pCtx context.Context, ik *domains.IdempotencyKey) (*IdempotencyKeyModel, bool, error) {
+	ctx, cancel := context.WithTimeout(pCtx, time.Second*5)
+	defer cancel()
+
+	nIdempotencyKeyModel := NewIdempotencyKeyModel(ik)
+	result, err := dbConn(ctx, ir.db).NewInsert().
+		Model(nIdempotencyKeyModel).
+		On("CONFLICT (principal, operation, idempotency_key) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		ir.logger.Error().Ctx(ctx).Err(err).
+			Str("operation", ik.Operation).
+			Str("idempotency_key", ik.Key).
+			Msg("failed to reserve idempotency key")
+		return nil, false, domainsErrors.DatabaseError(err, "reserve idempotency key")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		ir.logger.Error().Ctx(ctx).Err(err).
+			Str("idempotency_key", ik.Key).
+			Msg("failed to get rows affected after idempotency key insert")
+		return nil, false, domainsErrors.DatabaseError(err, "check idempotency key insert result")
+	}
+	if rowsAffected == 1 {
+		return nIdempotencyKeyModel, true, nil
+	}
+
+	existing := &IdempotencyKeyModel{}
+	err = dbConn(ctx, ir.db).NewSelect().
+		Model(existing).
+		Where("principal = ? AND operation = ? AND idempotency_key = ?", ik.Principal, ik.Operation, ik.Key).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, domainsErrors.NotFoundError("idempotency key", ik.Key)
+		}
+		ir.logger.Error().Ctx(ctx).Err(err).
+			Str("operation", ik.Operation).
+			Str("idempotency_key", ik.Key).
+			Msg("failed to get idempotency key")
+		return nil, false, domainsErrors.DatabaseError(err, "get idempotency key")
+	}
+
+	ir.logger.Debug().Ctx(ctx).
+		Str("operation", ik.Operation).
+		Str("idempotency_key", ik.Key).
+		Msg("idempotency key already used")
+	return existing, false, nil
+}
+
+// SetIdempotencyKeyResource links the idempotency key of the principal to the resource created by the request
+func (ir *IdempotencyKeyRepository) SetIdempotencyKeyResource(pCtx context.Context, principal string, operation string, key string, resourceUUID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(pCtx, time.Second*5)
+	defer cancel()
+
+	result, err := dbConn(ctx, ir.db).NewUpdate().
+		Model((*IdempotencyKeyModel)(nil)).
+		Set("resource_uuid = ?", resourceUUID).
+		Set("updated_at = ?", time.Now()).
+		Where("principal = ? AND operation = ? AND idempotency_key = ?", principal, operation, key).
+		Exec(ctx)
+	if err != nil {
+		ir.logger.Error().Ctx(ctx).Err(err).
+			Str("operation", operation).
+			Str("idempotency_key", key).
+			Msg("failed to update idempotency key")
+		return domainsErrors.DatabaseError(err, "update idempotency key")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domainsErrors.DatabaseError(err, "check idempotency key update result")
+	}
+	if rowsAffected == 0 {
+		return domainsErrors.NotFoundError("idempotency key", key)
+	}
+	return nil
+}
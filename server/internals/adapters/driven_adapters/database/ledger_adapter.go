@@ -0,0 +1,106 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	domainsErrors "github.com/cybrarymin/gRPC/server/internals/domains/errors"
+	"github.com/rs/zerolog"
+	"github.com/uptrace/bun"
+)
+
+type LedgerRepository struct {
+	db     *bun.DB
+	logger *zerolog.Logger
+}
+
+func NewLedgerRepository(db *bun.DB, logger *zerolog.Logger) *LedgerRepository {
+	return &LedgerRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// CreateJournalEntry stores the entry and its postings. It should run inside the unit of work that changes the balances,
+// the database rejects the commit when the postings of the entry don't sum up to zero in each currency.
+func (lr *LedgerRepository) CreateJournalEntry(pCtx context.Context, entry *domains.JournalEntry) error {
+	ctx, cancel := context.WithTimeout(pCtx, time.Second*5)
+	defer cancel()
+
+	entryModel, postingModels := NewLedgerEntryModel(entry)
+	_, err := dbConn(ctx, lr.db).NewInsert().Model(entryModel).Exec(ctx)
+	if err != nil {
+		lr.logger.Error().Ctx(ctx).Err(err).
+			Str("entry_uuid", entry.EntryUUID.String()).
+			Str("entry_type", entry.EntryType).
+			Str("reference_uuid", entry.ReferenceUUID.String()).
+			Msg("failed to create journal entry")
+		return domainsErrors.DatabaseError(err, "create journal entry")
+	}
+
+	_, err = dbConn(ctx, lr.db).NewInsert().Model(&postingModels).Exec(ctx)
+	if err != nil {
+		lr.logger.Error().Ctx(ctx).Err(err).
+			Str("entry_uuid", entry.EntryUUID.String()).
+			Str("entry_type", entry.EntryType).
+			Msg("failed to create journal entry postings")
+		return domainsErrors.DatabaseError(err, "create journal entry postings")
+	}
+	return nil
+}
+
+// CountAccounts returns the number of bank accounts covered by the ledger verification
+func (lr *LedgerRepository) CountAccounts(pCtx context.Context) (int, error) {
+	ctx, cancel := context.WithTimeout(pCtx, time.Second*30)
+	defer cancel()
+
+	count, err := dbConn(ctx, lr.db).NewSelect().Model((*BankAccountModel)(nil)).Count(ctx)
+	if err != nil {
+		lr.logger.Error().Ctx(ctx).Err(err).Msg("failed to count bank accounts")
+		return 0, domainsErrors.DatabaseError(err, "count bank accounts")
+	}
+	return count, nil
+}
+
+// FindBalanceMismatches returns the bank accounts whose stored balance differs from the sum of their postings
+func (lr *LedgerRepository) FindBalanceMismatches(pCtx context.Context) ([]LedgerBalanceMismatchModel, error) {
+	ctx, cancel := context.WithTimeout(pCtx, time.Second*30)
+	defer cancel()
+
+	mismatches := make([]LedgerBalanceMismatchModel, 0)
+	err := dbConn(ctx, lr.db).NewSelect().
+		TableExpr("bank_accounts AS a").
+		Join("LEFT JOIN ledger_postings AS p ON p.account_uuid = a.account_uuid").
+		ColumnExpr("a.account_uuid, a.currency, a.current_balance").
+		ColumnExpr("COALESCE(SUM(p.amount), 0) AS ledger_balance").
+		GroupExpr("a.account_uuid").
+		Having("a.current_balance <> COALESCE(SUM(p.amount), 0)").
+		OrderExpr("a.account_uuid").
+		Scan(ctx, &mismatches)
+	if err != nil {
+		lr.logger.Error().Ctx(ctx).Err(err).Msg("failed to compare account balances with the ledger")
+		return nil, domainsErrors.DatabaseError(err, "verify ledger balances")
+	}
+	return mismatches, nil
+}
+
+// FindUnbalancedEntries returns the journal entries whose postings don't sum up to zero in a currency
+func (lr *LedgerRepository) FindUnbalancedEntries(pCtx context.Context) ([]UnbalancedEntryModel, error) {
+	ctx, cancel := context.WithTimeout(pCtx, time.Second*30)
+	defer cancel()
+
+	entries := make([]UnbalancedEntryModel, 0)
+	err := dbConn(ctx, lr.db).NewSelect().
+		Model((*LedgerPostingModel)(nil)).
+		ColumnExpr("entry_uuid, currency, SUM(amount) AS imbalance").
+		GroupExpr("entry_uuid, currency").
+		Having("SUM(amount) <> 0").
+		OrderExpr("entry_uuid").
+		Scan(ctx, &entries)
+	if err != nil {
+		lr.logger.Error().Ctx(ctx).Err(err).Msg("failed to look for unbalanced journal entries")
+		return nil, domainsErrors.DatabaseError(err, "verify journal entries")
+	}
+	return entries, nil
+}
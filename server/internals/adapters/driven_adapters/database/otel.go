@@ -3,19 +3,56 @@ package adapters
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
 	"time"
 
+	"github.com/rs/zerolog"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
 	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
+// Trace exporters, named after the values of OTEL_TRACES_EXPORTER
+const (
+	TracesExporterOTLP    = "otlp"
+	TracesExporterConsole = "console"
+	TracesExporterStdout  = "stdout"
+	TracesExporterNone    = "none"
+)
+
+// OTLP protocols, named after the values of OTEL_EXPORTER_OTLP_PROTOCOL
+const (
+	OTLPProtocolGRPC         = "grpc"
+	OTLPProtocolHTTPProtobuf = "http/protobuf"
+)
+
+// OTelConfig selects where the spans go and how they are sampled.
+// Unset OTLP endpoint, insecure and timeout options fall back to the standard OTEL_EXPORTER_OTLP_* variables read by the exporters,
+// and OTEL_RESOURCE_ATTRIBUTES adds resource attributes on top of the configured ones.
+type OTelConfig struct {
+	TracesExporter        string        // otlp, console (or stdout) or none
+	OTLPProtocol          string        // grpc or http/protobuf
+	OTLPEndpoint          string        // endpoint url, e.g. http://localhost:4318. A http scheme disables TLS
+	OTLPInsecure          bool          // disable TLS towards the collector
+	OTLPTimeout           time.Duration // export timeout
+	SamplerRatio          float64       // ratio of the root traces recorded, child spans follow the decision of their parent
+	ServiceName           string
+	DeploymentEnvironment string
+	Logger                *zerolog.Logger
+}
+
 // setupOTelSDK bootstraps the OpenTelemetry pipeline.
 // If it does not return an error, make sure to call shutdown for proper cleanup.
-func SetupOTelSDK(ctx context.Context) (shutdown func(context.Context) error, err error) {
+func SetupOTelSDK(ctx context.Context, cfg OTelConfig) (shutdown func(context.Context) error, err error) {
 	var shutdownFuncs []func(context.Context) error
 
 	// shutdown calls cleanup functions registered via shutdownFuncs.
@@ -35,25 +72,42 @@ func SetupOTelSDK(ctx context.Context) (shutdown func(context.Context) error, er
 		err = errors.Join(inErr, shutdown(ctx))
 	}
 
+	// export failures happen in the background, report them through the application logger
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		cfg.Logger.Warn().Err(err).Msg("opentelemetry export failed")
+	}))
+
 	// Set up propagator.
 	prop := newPropagator()
 	otel.SetTextMapPropagator(prop)
 
-	// Set up Jaeger exporter
-	traceExporter, err := newJaegerTraceExporter(ctx)
+	rattr, err := newResource(ctx, cfg)
 	if err != nil {
 		handleErr(err)
 		return
 	}
-	// Set up trace provider.
-	tracerProvider, err := newTraceProvider(traceExporter)
+
+	// Set up trace exporter. Without exporter the global tracer provider stays a no-op
+	traceExporter, err := newTraceExporter(ctx, cfg)
 	if err != nil {
 		handleErr(err)
 		return
 	}
+	if traceExporter != nil {
+		// Set up trace provider.
+		tracerProvider := newTraceProvider(traceExporter, rattr, cfg.SamplerRatio)
+		shutdownFuncs = append(shutdownFuncs, tracerProvider.Shutdown)
+		otel.SetTracerProvider(tracerProvider)
+	}
 
-	shutdownFuncs = append(shutdownFuncs, tracerProvider.Shutdown)
-	otel.SetTracerProvider(tracerProvider)
+	// Set up meter provider. The metrics are pulled by prometheus from the default registry, see promhttp.Handler
+	meterProvider, err := newMeterProvider(rattr)
+	if err != nil {
+		handleErr(err)
+		return
+	}
+	shutdownFuncs = append(shutdownFuncs, meterProvider.Shutdown)
+	otel.SetMeterProvider(meterProvider)
 
 	return
 }
@@ -66,18 +120,47 @@ func newPropagator() propagation.TextMapPropagator {
 	)
 }
 
-// Create an exporter over HTTP for Jaeger endpoint. In latest version, Jaeger supports otlp endpoint
-func newJaegerTraceExporter(ctx context.Context) (trace.SpanExporter, error) {
-	traceExporter, err := otlptracehttp.New(ctx,
-		otlptracehttp.WithEndpoint("localhost:4318"), // Jaeger endpoint
-		otlptracehttp.WithInsecure(),                 // us http instead of https
-		otlptracehttp.WithTimeout(5*time.Second))
-
-	if err != nil {
-		return nil, err
+// Create the span exporter selected by the configuration. Jaeger, tempo and the otel collector all accept OTLP over grpc and http.
+// It returns a nil exporter when the traces are disabled.
+func newTraceExporter(ctx context.Context, cfg OTelConfig) (trace.SpanExporter, error) {
+	switch cfg.TracesExporter {
+	case TracesExporterNone:
+		return nil, nil
+	case TracesExporterConsole, TracesExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stderr))
+	case TracesExporterOTLP:
+	default:
+		return nil, fmt.Errorf("unsupported traces exporter %q, should be one of otlp, console, stdout or none", cfg.TracesExporter)
 	}
 
-	return traceExporter, nil
+	switch cfg.OTLPProtocol {
+	case OTLPProtocolGRPC:
+		opts := []otlptracegrpc.Option{}
+		if cfg.OTLPEndpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpointURL(cfg.OTLPEndpoint))
+		}
+		if cfg.OTLPInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		if cfg.OTLPTimeout > 0 {
+			opts = append(opts, otlptracegrpc.WithTimeout(cfg.OTLPTimeout))
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	case OTLPProtocolHTTPProtobuf:
+		opts := []otlptracehttp.Option{}
+		if cfg.OTLPEndpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpointURL(cfg.OTLPEndpoint))
+		}
+		if cfg.OTLPInsecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		if cfg.OTLPTimeout > 0 {
+			opts = append(opts, otlptracehttp.WithTimeout(cfg.OTLPTimeout))
+		}
+		return otlptracehttp.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("unsupported otlp protocol %q, should be grpc or http/protobuf", cfg.OTLPProtocol)
+	}
 }
 
 // To be able to create span
@@ -85,20 +168,46 @@ func newJaegerTraceExporter(ctx context.Context) (trace.SpanExporter, error) {
 // Then with that exporter create a traceProvider
 // Using traceProvider to setup the global tracer
 // use the tracer to create span
-func newTraceProvider(traceExporter trace.SpanExporter) (*trace.TracerProvider, error) {
-	// define resource attributes. resource attributes are attrs such as pod name, service name, os, arch and...
-	rattr, err := resource.Merge(
-		resource.Default(),
-		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName("bankservice")))
-	if err != nil {
-		return nil, err
-	}
-
-	traceProvider := trace.NewTracerProvider(
+func newTraceProvider(traceExporter trace.SpanExporter, rattr *resource.Resource, samplerRatio float64) *trace.TracerProvider {
+	return trace.NewTracerProvider(
 		trace.WithBatcher(traceExporter,
 			// Default is 5s. Set to 1s for demonstrative purposes.
 			trace.WithBatchTimeout(time.Second)),
 		trace.WithResource(rattr),
+		// sample the root spans by ratio and keep the decision of the remote parent so a trace is never recorded partially
+		trace.WithSampler(trace.ParentBased(trace.TraceIDRatioBased(samplerRatio))),
 	)
-	return traceProvider, nil
+}
+
+// The meter provider registers the metrics on the prometheus default registry, each scrape collects the current values
+func newMeterProvider(rattr *resource.Resource) (*metric.MeterProvider, error) {
+	exporter, err := prometheus.New()
+	if err != nil {
+		return nil, err
+	}
+	return metric.NewMeterProvider(
+		metric.WithReader(exporter),
+		metric.WithResource(rattr),
+	), nil
+}
+
+// define resource attributes. resource attributes are attrs such as pod name, service name, os, arch and...
+// The configured attributes win over the ones of OTEL_RESOURCE_ATTRIBUTES and OTEL_SERVICE_NAME.
+func newResource(ctx context.Context, cfg OTelConfig) (*resource.Resource, error) {
+	attrs := []attribute.KeyValue{semconv.ServiceName(cfg.ServiceName)}
+	if cfg.DeploymentEnvironment != "" {
+		attrs = append(attrs, semconv.DeploymentEnvironment(cfg.DeploymentEnvironment))
+	}
+	detected, err := resource.New(ctx,
+		resource.WithTelemetrySDK(),
+		resource.WithHost(),
+		resource.WithProcessPID(),
+		resource.WithProcessRuntimeName(),
+		resource.WithProcessRuntimeVersion(),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resource.Merge(detected, resource.NewWithAttributes(semconv.SchemaURL, attrs...))
 }
@@ -0,0 +1,55 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rs/zerolog"
+	"github.com/uptrace/bun"
+)
+
+type dbTxCtxKey string
+
+var (
+	DbCtxTxKey dbTxCtxKey = "db_tx"
+)
+
+// UnitOfWork runs a group of repository calls inside a single database transaction.
+// The transaction travels through the context so the existing repositories pick it up transparently.
+type UnitOfWork struct {
+	db     *bun.DB
+	logger *zerolog.Logger
+}
+
+func NewUnitOfWork(db *bun.DB, logger *zerolog.Logger) *UnitOfWork {
+	return &UnitOfWork{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// WithinTransaction begins a new transaction, hands the transactional context to fn and commits if fn returns nil.
+// Any error returned by fn rolls the whole transaction back.
+// If the context already carries a transaction, fn joins it instead of opening a nested one.
+func (uw *UnitOfWork) WithinTransaction(pCtx context.Context, fn func(txCtx context.Context) error) error {
+	if _, exists := pCtx.Value(DbCtxTxKey).(bun.Tx); exists {
+		return fn(pCtx)
+	}
+
+	err := uw.db.RunInTx(pCtx, &sql.TxOptions{Isolation: sql.LevelReadCommitted}, func(ctx context.Context, tx bun.Tx) error {
+		return fn(context.WithValue(ctx, DbCtxTxKey, tx))
+	})
+	if err != nil {
+		uw.logger.Debug().Ctx(pCtx).Err(err).Msg("database transaction rolled back")
+		return err
+	}
+	return nil
+}
+
+// dbConn returns the transaction carried by the context if there is one, otherwise the database connection pool.
+func dbConn(ctx context.Context, db *bun.DB) bun.IDB {
+	if tx, exists := ctx.Value(DbCtxTxKey).(bun.Tx); exists {
+		return tx
+	}
+	return db
+}
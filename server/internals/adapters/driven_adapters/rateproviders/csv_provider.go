@@ -0,0 +1,79 @@
+package adapters
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	entities "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	"github.com/rs/zerolog"
+)
+
+// CSVFileProvider reads the rates from a CSV file with a from_currency,to_currency,rate record per pair, e.g.
+//
+//	from_currency,to_currency,rate
+//	USD,EUR,0.9213
+//
+// The header, from_currency or from in the first column, is optional, lines starting with # are comments. The file is watched for changes.
+type CSVFileProvider struct {
+	file   string
+	logger *zerolog.Logger
+}
+
+func NewCSVFileProvider(file string, logger *zerolog.Logger) *CSVFileProvider {
+	return &CSVFileProvider{
+		file:   file,
+		logger: logger,
+	}
+}
+
+func (p *CSVFileProvider) Name() string {
+	return "csv-file"
+}
+
+func (p *CSVFileProvider) FetchRates(ctx context.Context) ([]entities.ProvidedRate, error) {
+	f, err := os.Open(p.file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the csv rates file: %w", err)
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	rates := []entities.ProvidedRate{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the csv rates file: %w", err)
+		}
+		if header := strings.ToLower(record[0]); header == "from_currency" || header == "from" {
+			continue
+		}
+		if _, err := entities.ParseRate(record[2]); err != nil {
+			return nil, fmt.Errorf("invalid %s/%s rate in the csv rates file: %w", record[0], record[1], err)
+		}
+		rates = append(rates, entities.ProvidedRate{
+			Pair: entities.CurrencyPair{From: strings.ToUpper(record[0]), To: strings.ToUpper(record[1])},
+			Rate: strings.TrimSpace(record[2]),
+		})
+	}
+
+	p.logger.Debug().Ctx(ctx).
+		Str("file", p.file).
+		Int("rates", len(rates)).
+		Msg("read csv rates file")
+	return rates, nil
+}
+
+func (p *CSVFileProvider) Watch(ctx context.Context) (<-chan struct{}, error) {
+	return watchFile(ctx, p.file, p.logger)
+}
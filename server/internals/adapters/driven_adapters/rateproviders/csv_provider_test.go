@@ -0,0 +1,86 @@
+package adapters
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	entities "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	"github.com/rs/zerolog"
+)
+
+func TestCSVFileProviderFetchRates(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []entities.ProvidedRate
+		wantErr bool
+	}{
+		{
+			name:    "with header",
+			content: "from_currency,to_currency,rate\nUSD,EUR,0.9213\nGBP,USD,1.2741\n",
+			want: []entities.ProvidedRate{
+				{Pair: entities.CurrencyPair{From: "GBP", To: "USD"}, Rate: "1.2741"},
+				{Pair: entities.CurrencyPair{From: "USD", To: "EUR"}, Rate: "0.9213"},
+			},
+		},
+		{
+			name:    "short header, comments and lower case currencies",
+			content: "# rates of the day\nFrom,To,Rate\nusd, jpy, 148.61\n",
+			want: []entities.ProvidedRate{
+				{Pair: entities.CurrencyPair{From: "USD", To: "JPY"}, Rate: "148.61"},
+			},
+		},
+		{
+			name:    "without header",
+			content: "USD,EUR,0.9213\n",
+			want: []entities.ProvidedRate{
+				{Pair: entities.CurrencyPair{From: "USD", To: "EUR"}, Rate: "0.9213"},
+			},
+		},
+		{
+			name:    "empty file",
+			content: "",
+			want:    []entities.ProvidedRate{},
+		},
+		{
+			name:    "missing column",
+			content: "USD,EUR\n",
+			wantErr: true,
+		},
+		{
+			name:    "negative rate",
+			content: "USD,EUR,-0.9213\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid rate",
+			content: "USD,EUR,abc\n",
+			wantErr: true,
+		},
+	}
+
+	logger := zerolog.Nop()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "rates.csv")
+			if err := os.WriteFile(file, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			provider := NewCSVFileProvider(file, &logger)
+			got, err := provider.FetchRates(context.Background())
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertProvidedRates(t, got, tt.want)
+		})
+	}
+}
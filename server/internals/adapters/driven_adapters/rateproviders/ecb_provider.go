@@ -0,0 +1,92 @@
+package adapters
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"os"
+
+	entities "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	"github.com/rs/zerolog"
+)
+
+// ecbBaseCurrency is the currency all the rates of the ECB reference rates file convert from
+const ecbBaseCurrency = "EUR"
+
+// ecbEnvelope is the layout of the ECB euro foreign exchange reference rates file, e.g. eurofxref-daily.xml:
+//
+//	<gesmes:Envelope>
+//	  <Cube>
+//	    <Cube time="2025-03-14">
+//	      <Cube currency="USD" rate="1.0882"/>
+//
+// Only the latest day of a history file (eurofxref-hist.xml) is used.
+type ecbEnvelope struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+// ECBFileProvider reads the rates from EUR of an ECB reference rates file, the file is watched for changes
+type ECBFileProvider struct {
+	file   string
+	logger *zerolog.Logger
+}
+
+func NewECBFileProvider(file string, logger *zerolog.Logger) *ECBFileProvider {
+	return &ECBFileProvider{
+		file:   file,
+		logger: logger,
+	}
+}
+
+func (p *ECBFileProvider) Name() string {
+	return "ecb-file"
+}
+
+func (p *ECBFileProvider) FetchRates(ctx context.Context) ([]entities.ProvidedRate, error) {
+	raw, err := os.ReadFile(p.file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the ecb rates file: %w", err)
+	}
+	envelope := &ecbEnvelope{}
+	if err := xml.Unmarshal(raw, envelope); err != nil {
+		return nil, fmt.Errorf("failed to parse the ecb rates file: %w", err)
+	}
+	if len(envelope.Days) == 0 {
+		return nil, fmt.Errorf("ecb rates file %s has no rates", p.file)
+	}
+
+	// the daily file has a single day, the history file starts with the latest one
+	latest := envelope.Days[0]
+	for _, day := range envelope.Days[1:] {
+		if day.Time > latest.Time {
+			latest = day
+		}
+	}
+	rates := make([]entities.ProvidedRate, 0, len(latest.Rates))
+	for _, r := range latest.Rates {
+		if _, err := entities.ParseRate(r.Rate); err != nil {
+			return nil, fmt.Errorf("invalid %s rate in the ecb rates file: %w", r.Currency, err)
+		}
+		rates = append(rates, entities.ProvidedRate{
+			Pair: entities.CurrencyPair{From: ecbBaseCurrency, To: r.Currency},
+			Rate: r.Rate,
+		})
+	}
+
+	p.logger.Debug().Ctx(ctx).
+		Str("file", p.file).
+		Str("rates_date", latest.Time).
+		Int("rates", len(rates)).
+		Msg("read ecb reference rates")
+	return rates, nil
+}
+
+func (p *ECBFileProvider) Watch(ctx context.Context) (<-chan struct{}, error) {
+	return watchFile(ctx, p.file, p.logger)
+}
@@ -0,0 +1,102 @@
+package adapters
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	entities "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	"github.com/rs/zerolog"
+)
+
+func TestECBFileProviderFetchRates(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []entities.ProvidedRate
+		wantErr bool
+	}{
+		{
+			name: "daily file",
+			content: `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<Cube>
+		<Cube time="2025-03-14">
+			<Cube currency="USD" rate="1.0882"/>
+			<Cube currency="JPY" rate="161.05"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`,
+			want: []entities.ProvidedRate{
+				{Pair: entities.CurrencyPair{From: "EUR", To: "JPY"}, Rate: "161.05"},
+				{Pair: entities.CurrencyPair{From: "EUR", To: "USD"}, Rate: "1.0882"},
+			},
+		},
+		{
+			name: "history file uses the latest day",
+			content: `<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<Cube>
+		<Cube time="2025-03-13"><Cube currency="USD" rate="1.0851"/></Cube>
+		<Cube time="2025-03-14"><Cube currency="USD" rate="1.0882"/></Cube>
+		<Cube time="2025-03-12"><Cube currency="USD" rate="1.0893"/></Cube>
+	</Cube>
+</gesmes:Envelope>`,
+			want: []entities.ProvidedRate{
+				{Pair: entities.CurrencyPair{From: "EUR", To: "USD"}, Rate: "1.0882"},
+			},
+		},
+		{
+			name:    "malformed xml",
+			content: `<gesmes:Envelope><Cube><Cube time="2025-03-14">`,
+			wantErr: true,
+		},
+		{
+			name:    "no rates",
+			content: `<gesmes:Envelope><Cube></Cube></gesmes:Envelope>`,
+			wantErr: true,
+		},
+		{
+			name:    "zero rate",
+			content: `<gesmes:Envelope><Cube><Cube time="2025-03-14"><Cube currency="USD" rate="0"/></Cube></Cube></gesmes:Envelope>`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid rate",
+			content: `<gesmes:Envelope><Cube><Cube time="2025-03-14"><Cube currency="USD" rate="N/A"/></Cube></Cube></gesmes:Envelope>`,
+			wantErr: true,
+		},
+	}
+
+	logger := zerolog.Nop()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "eurofxref-daily.xml")
+			if err := os.WriteFile(file, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			provider := NewECBFileProvider(file, &logger)
+			got, err := provider.FetchRates(context.Background())
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertProvidedRates(t, got, tt.want)
+		})
+	}
+}
+
+func TestECBFileProviderMissingFile(t *testing.T) {
+	logger := zerolog.Nop()
+	provider := NewECBFileProvider(filepath.Join(t.TempDir(), "missing.xml"), &logger)
+	if _, err := provider.FetchRates(context.Background()); err == nil {
+		t.Fatal("expected an error for a missing file")
+	}
+}
@@ -0,0 +1,58 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog"
+)
+
+// watchFile signals the returned channel every time the file is written, replaced or renamed into place.
+// The watcher stops and the channel is closed once the context is done.
+func watchFile(ctx context.Context, file string, logger *zerolog.Logger) (<-chan struct{}, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch the rates file: %w", err)
+	}
+	// the directory is watched rather than the file so atomic renames and kubernetes configmap symlink swaps are noticed
+	if err := watcher.Add(filepath.Dir(file)); err != nil {
+		watcher.Close()
+		return nil, fmt.Errorf("failed to watch %s: %w", filepath.Dir(file), err)
+	}
+
+	changes := make(chan struct{}, 1)
+	go func() {
+		defer close(changes)
+		defer watcher.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				name := filepath.Base(event.Name)
+				if filepath.Clean(event.Name) != filepath.Clean(file) && name != "..data" {
+					continue
+				}
+				if !event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) && !event.Has(fsnotify.Rename) {
+					continue
+				}
+				// a burst of events collapses into a single change
+				select {
+				case changes <- struct{}{}:
+				default:
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logger.Error().Err(err).Str("file", file).Msg("rates file watcher failed")
+			}
+		}
+	}()
+	return changes, nil
+}
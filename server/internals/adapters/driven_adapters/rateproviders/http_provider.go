@@ -0,0 +1,99 @@
+package adapters
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	entities "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	"github.com/rs/zerolog"
+)
+
+// maxHTTPRatesBody bounds the size of the response read from the rates endpoint
+const maxHTTPRatesBody = 1 << 20
+
+// httpRatesResponse is the JSON document served by the rates endpoint, e.g.
+//
+//	{"base": "USD", "rates": {"EUR": 0.9213, "JPY": 148.61}}
+//
+// The rates may be numbers or strings, they're kept as exact decimals either way.
+type httpRatesResponse struct {
+	Base  string                 `json:"base"`
+	Rates map[string]json.Number `json:"rates"`
+}
+
+// HTTPJSONProvider polls a JSON endpoint publishing the rates from a base currency
+type HTTPJSONProvider struct {
+	url    string
+	client *http.Client
+	logger *zerolog.Logger
+}
+
+func NewHTTPJSONProvider(url string, timeout time.Duration, logger *zerolog.Logger) *HTTPJSONProvider {
+	return &HTTPJSONProvider{
+		url: url,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+		logger: logger,
+	}
+}
+
+func (p *HTTPJSONProvider) Name() string {
+	return "http-json"
+}
+
+func (p *HTTPJSONProvider) FetchRates(ctx context.Context) ([]entities.ProvidedRate, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rates endpoint: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the rates from %s: %w", p.url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("rates endpoint %s answered %s", p.url, resp.Status)
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPRatesBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the rates from %s: %w", p.url, err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	body := &httpRatesResponse{}
+	if err := decoder.Decode(body); err != nil {
+		return nil, fmt.Errorf("failed to parse the rates from %s: %w", p.url, err)
+	}
+	if body.Base == "" {
+		return nil, fmt.Errorf("rates from %s have no base currency", p.url)
+	}
+
+	rates := make([]entities.ProvidedRate, 0, len(body.Rates))
+	for currency, rate := range body.Rates {
+		// a single invalid rate makes the whole document untrustworthy
+		if _, err := entities.ParseRate(rate.String()); err != nil {
+			return nil, fmt.Errorf("invalid %s rate from %s: %w", currency, p.url, err)
+		}
+		rates = append(rates, entities.ProvidedRate{
+			Pair: entities.CurrencyPair{From: strings.ToUpper(body.Base), To: strings.ToUpper(currency)},
+			Rate: rate.String(),
+		})
+	}
+
+	p.logger.Debug().Ctx(ctx).
+		Str("url", p.url).
+		Str("base", body.Base).
+		Int("rates", len(rates)).
+		Msg("fetched rates from http endpoint")
+	return rates, nil
+}
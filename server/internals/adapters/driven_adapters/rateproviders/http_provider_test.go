@@ -0,0 +1,104 @@
+package adapters
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+	"time"
+
+	entities "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	"github.com/rs/zerolog"
+)
+
+func TestHTTPJSONProviderFetchRates(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    []entities.ProvidedRate
+		wantErr bool
+	}{
+		{
+			name:   "numbers and strings",
+			status: http.StatusOK,
+			body:   `{"base": "usd", "rates": {"EUR": 0.9213, "jpy": "148.61"}}`,
+			want: []entities.ProvidedRate{
+				{Pair: entities.CurrencyPair{From: "USD", To: "EUR"}, Rate: "0.9213"},
+				{Pair: entities.CurrencyPair{From: "USD", To: "JPY"}, Rate: "148.61"},
+			},
+		},
+		{
+			name:    "non 2xx response",
+			status:  http.StatusServiceUnavailable,
+			body:    `{"base": "USD", "rates": {"EUR": 0.9213}}`,
+			wantErr: true,
+		},
+		{
+			name:    "malformed json",
+			status:  http.StatusOK,
+			body:    `{"base": "USD", "rates": {"EUR": 0.92`,
+			wantErr: true,
+		},
+		{
+			name:    "missing base currency",
+			status:  http.StatusOK,
+			body:    `{"rates": {"EUR": 0.9213}}`,
+			wantErr: true,
+		},
+		{
+			name:    "zero rate",
+			status:  http.StatusOK,
+			body:    `{"base": "USD", "rates": {"EUR": 0.9213, "JPY": 0}}`,
+			wantErr: true,
+		},
+		{
+			name:    "negative rate",
+			status:  http.StatusOK,
+			body:    `{"base": "USD", "rates": {"EUR": -0.9213}}`,
+			wantErr: true,
+		},
+	}
+
+	logger := zerolog.Nop()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			provider := NewHTTPJSONProvider(srv.URL, time.Second, &logger)
+			got, err := provider.FetchRates(context.Background())
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertProvidedRates(t, got, tt.want)
+		})
+	}
+}
+
+// assertProvidedRates compares the rates regardless of their order, the json rates are read from a map
+func assertProvidedRates(t *testing.T, got []entities.ProvidedRate, want []entities.ProvidedRate) {
+	t.Helper()
+	sort.Slice(got, func(i, j int) bool {
+		return got[i].Pair.From+got[i].Pair.To < got[j].Pair.From+got[j].Pair.To
+	})
+	if len(got) != len(want) {
+		t.Fatalf("got %d rates %v, want %d rates %v", len(got), got, len(want), want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("rate %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+}
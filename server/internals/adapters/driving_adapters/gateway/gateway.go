@@ -0,0 +1,128 @@
+package adapters
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cybrarymin/gRPC/openapi"
+	"github.com/cybrarymin/gRPC/protogen/pb"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// forwardedHeaders are the http headers passed to the grpc server as metadata under the same name.
+// The authorization header is always forwarded by the gateway runtime.
+var forwardedHeaders = map[string]bool{
+	"x-api-key":       true,
+	"x-request-id":    true,
+	"idempotency-key": true,
+	"traceparent":     true,
+	"tracestate":      true,
+}
+
+// GatewayAdapter serves BankService as REST/JSON by translating the http requests to grpc calls on the given connection
+type GatewayAdapter struct {
+	Srv         *http.Server
+	conn        *grpc.ClientConn
+	gatewayHost string
+	gatewayPort string
+	logger      *zerolog.Logger
+}
+
+// NewGatewayAdapter creates the http gateway. The gateway serves plaintext when tlsCfg is nil.
+func NewGatewayAdapter(gatewayHost string, gatewayPort string, logger *zerolog.Logger, conn *grpc.ClientConn, tlsCfg *tls.Config) (*GatewayAdapter, error) {
+	jsonMarshaler := &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{
+			UseEnumNumbers: false,
+		},
+		UnmarshalOptions: protojson.UnmarshalOptions{
+			DiscardUnknown: true,
+		},
+	}
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, jsonMarshaler),
+		runtime.WithMarshalerOption(sseContentType, &sseMarshaler{JSONPb: jsonMarshaler}),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithErrorHandler(errorHandler),
+	)
+	if err := pb.RegisterBankServiceHandler(context.Background(), mux, conn); err != nil {
+		logger.Error().Err(err).Msg("failed to register the bank service on the http gateway")
+		return nil, err
+	}
+	if err := mux.HandlePath(http.MethodGet, "/openapi.json", serveOpenAPI); err != nil {
+		logger.Error().Err(err).Msg("failed to register the openapi document on the http gateway")
+		return nil, err
+	}
+
+	return &GatewayAdapter{
+		Srv: &http.Server{
+			Addr:              net.JoinHostPort(gatewayHost, gatewayPort),
+			Handler:           mux,
+			TLSConfig:         tlsCfg,
+			ReadHeaderTimeout: 10 * time.Second,
+			IdleTimeout:       2 * time.Minute,
+			// no write timeout, the exchange rate stream lasts as long as the client listens
+		},
+		conn:        conn,
+		gatewayHost: gatewayHost,
+		gatewayPort: gatewayPort,
+		logger:      logger,
+	}, nil
+}
+
+func (ad *GatewayAdapter) Run() error {
+	ad.logger.Info().Msgf("starting http gateway on %s:%s", ad.gatewayHost, ad.gatewayPort)
+
+	var err error
+	if ad.Srv.TLSConfig != nil {
+		// the certificates come from the tls configuration
+		err = ad.Srv.ListenAndServeTLS("", "")
+	} else {
+		ad.logger.Warn().Msg("http gateway tls is disabled, serving plaintext")
+		err = ad.Srv.ListenAndServe()
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		ad.logger.Error().Err(err).Msg("http gateway failed to serve")
+		return err
+	}
+	return nil
+}
+
+func (ad *GatewayAdapter) Stop(ctx context.Context) error {
+	ad.logger.Info().Msg("gracefully stopping http gateway")
+	if err := ad.Srv.Shutdown(ctx); err != nil {
+		ad.logger.Warn().Err(err).Msg("timeout during graceful shutdown, forcing http gateway to stop")
+		ad.Srv.Close()
+	}
+	ad.logger.Info().Msg("http gateway stopped")
+	return ad.conn.Close()
+}
+
+// incomingHeaderMatcher forwards the credentials, request id, idempotency key and trace context headers on top of the default ones
+func incomingHeaderMatcher(key string) (string, bool) {
+	if lower := strings.ToLower(key); forwardedHeaders[lower] {
+		return lower, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher returns the request id as the X-Request-Id header, the other response metadata keep the Grpc-Metadata- prefix
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if strings.ToLower(key) == "x-request-id" {
+		return "X-Request-Id", true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+func serveOpenAPI(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(openapi.BankServiceSpec)
+}
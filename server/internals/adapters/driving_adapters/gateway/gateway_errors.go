@@ -0,0 +1,70 @@
+package adapters
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// preconditionHTTPStatus maps the precondition violations reported by the grpc server to http status codes.
+// A request conflicting with the current state of a resource is a 409, a request which can never succeed as sent is a 422.
+var preconditionHTTPStatus = map[string]int{
+	"INSUFFICIENT_BALANCE":      http.StatusUnprocessableEntity,
+	"IDEMPOTENCY_KEY_REUSED":    http.StatusUnprocessableEntity,
+	"ACCOUNT_FROZEN":            http.StatusConflict,
+	"ACCOUNT_CLOSED":            http.StatusConflict,
+	"ACCOUNT_BALANCE_NOT_ZERO":  http.StatusConflict,
+	"INVALID_STATUS_TRANSITION": http.StatusConflict,
+	"EXCHANGE_RATE_STALE":       http.StatusConflict,
+}
+
+// errorHandler writes the grpc status as the JSON error body with the http status code matching the status code and details.
+// Rate limited requests get a Retry-After header and unauthenticated requests a WWW-Authenticate challenge.
+func errorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	st := status.Convert(err)
+	headers := http.Header{}
+	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+
+	switch st.Code() {
+	case codes.FailedPrecondition:
+		for _, detail := range st.Details() {
+			if failure, ok := detail.(*errdetails.PreconditionFailure); ok && len(failure.Violations) > 0 {
+				if code, exists := preconditionHTTPStatus[failure.Violations[0].Type]; exists {
+					httpStatus = code
+				}
+			}
+		}
+	case codes.ResourceExhausted:
+		for _, detail := range st.Details() {
+			if retry, ok := detail.(*errdetails.RetryInfo); ok {
+				seconds := int(math.Ceil(retry.RetryDelay.AsDuration().Seconds()))
+				headers.Set("Retry-After", strconv.Itoa(max(seconds, 1)))
+			}
+		}
+	case codes.Unauthenticated:
+		headers.Set("WWW-Authenticate", `Bearer realm="bank"`)
+	}
+
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, &headerOverrideWriter{ResponseWriter: w, headers: headers}, r,
+		&runtime.HTTPStatusError{HTTPStatus: httpStatus, Err: err})
+}
+
+// headerOverrideWriter sets its headers right before the status line is written,
+// replacing the ones the default error handler derives from the status
+type headerOverrideWriter struct {
+	http.ResponseWriter
+	headers http.Header
+}
+
+func (w *headerOverrideWriter) WriteHeader(code int) {
+	for key, values := range w.headers {
+		w.ResponseWriter.Header()[key] = values
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
@@ -0,0 +1,40 @@
+package adapters
+
+import (
+	"bytes"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+)
+
+const sseContentType = "text/event-stream"
+
+// sseMarshaler writes the streamed responses as server-sent events, it's picked by the Accept: text/event-stream header.
+// Every message is a "data:" event and a failing stream ends with an "error" event carrying the grpc status.
+// Without the header the streams are written as newline delimited JSON objects.
+type sseMarshaler struct {
+	*runtime.JSONPb
+}
+
+func (m *sseMarshaler) Marshal(v interface{}) ([]byte, error) {
+	event := []byte("data: ")
+	// the gateway wraps the stream errors as {"error": status}
+	if chunk, ok := v.(map[string]proto.Message); ok && chunk["error"] != nil {
+		event = []byte("event: error\ndata: ")
+	}
+	raw, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	// the data of an event can't span several lines
+	raw = bytes.ReplaceAll(raw, []byte("\n"), []byte(""))
+	return append(event, raw...), nil
+}
+
+func (m *sseMarshaler) ContentType(_ interface{}) string {
+	return sseContentType
+}
+
+func (m *sseMarshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
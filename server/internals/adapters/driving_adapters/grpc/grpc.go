@@ -2,71 +2,101 @@ package adapters
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"net"
 	"time"
 
 	"github.com/cybrarymin/gRPC/protogen/pb"
+	entities "github.com/cybrarymin/gRPC/server/internals/domains/entities"
 	domains "github.com/cybrarymin/gRPC/server/internals/domains/ports"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	// IdempotencyKeyMetadata is the metadata key a client may use instead of the request idempotency_key field
+	IdempotencyKeyMetadata  = "idempotency-key"
+	maxIdempotencyKeyLength = 255
+)
+
 type GrpcPortReference struct {
 	domains.BankAccountGrpcPort
 	domains.TransactionGrpcPort
 	domains.BankExchangeRateGrpcPort
 	domains.BankTransferGrpcPort
-	domains.ValidatorGrpcPort
+	domains.LedgerGrpcPort
+	domains.HealthGrpcPort
 }
 
 type GrpcAdapter struct {
-	port     GrpcPortReference
-	Srv      *grpc.Server
-	grpcPort string
-	grpcHost string
-	logger   *zerolog.Logger
+	port         GrpcPortReference
+	Srv          *grpc.Server
+	grpcPort     string
+	grpcHost     string
+	logger       *zerolog.Logger
+	certReloader *certReloader
+	health       *healthMonitor
+	rateLimiter  *RateLimiter
+	// inProcessSrv serves the http gateway over an in-memory listener
+	inProcessSrv      *grpc.Server
+	inProcessListener *inProcessListener
 	pb.BankServiceServer
 }
 
 func (ad *GrpcAdapter) OpenAccount(ctx context.Context, req *pb.BankAccountCreateRequest) (*pb.BankAccountCreateResponse, error) {
-	nlogger := ad.logger.With().Interface("request-id", ctx.Value(RpcCtxRequestIDKey)).Logger()
-	ad.logger = &nlogger
-
 	sCtx, nSpan := otel.Tracer("OpenAccount").Start(ctx, "OpenAccount.span")
 	defer nSpan.End()
 
-	ad.logger.Info().
+	ad.logger.Info().Ctx(sCtx).
 		Str("account_name", req.AccountName).
 		Str("account_number", req.AccountNumber).
 		Str("currency", req.Currency.String()).
-		Float64("balance", req.CurrentBalance).
+		Str("balance", req.CurrentBalance.String()).
+		Str("overdraft_limit", req.OverdraftLimit.String()).
 		Msg("received open account request")
 
-	ad.port.Validate(len(req.AccountNumber) == 10, "account_number", "account number length should be 10 digit")
-	ad.port.Validate(req.CurrentBalance >= 0, "account_balance", "account balance shouldn't be a negative number")
-	if _, exists := pb.Currency_value[req.Currency.String()]; !exists {
-		ad.port.AddError("currency", "unsupported currency")
+	// field level constraints are enforced by the validation interceptor, only the cross field rules are checked here
+	violations := make(map[string]string)
+	balance, err := pbToMoney(req.CurrentBalance)
+	if err != nil {
+		addViolation(violations, "current_balance", err.Error())
+	} else if balance.Currency != req.Currency.String() {
+		addViolation(violations, "current_balance", "account balance currency should match the account currency")
 	}
 
-	if !ad.port.Valid() {
-		ad.logger.Error().
-			Interface("validation_errors", ad.port.ValidatorErrors()).
+	// overdraft limit is optional and defaults to zero in the account currency
+	overdraftLimit := entities.NewMoney(0, req.Currency.String())
+	if req.OverdraftLimit != nil {
+		overdraftLimit, err = pbToMoney(req.OverdraftLimit)
+		if err != nil {
+			addViolation(violations, "overdraft_limit", err.Error())
+		} else if overdraftLimit.Currency != req.Currency.String() {
+			addViolation(violations, "overdraft_limit", "overdraft limit currency should match the account currency")
+		}
+	}
+
+	if len(violations) > 0 {
+		ad.logger.Error().Ctx(sCtx).
+			Interface("validation_errors", violations).
 			Msg("account creation validation failed")
 		nSpan.SetStatus(codes.Error, "failed to validate user input")
-		return nil, StatusCheck(ad.port.ValidatorErrors())
+		return nil, StatusCheck(violations)
 	}
 
-	createdAcc, err := ad.port.OpenAccount(sCtx, req.AccountName, req.AccountNumber, req.Currency.String(), req.CurrentBalance)
+	createdAcc, err := ad.port.OpenAccount(sCtx, req.AccountName, req.AccountNumber, req.Currency.String(), balance, overdraftLimit, req.OwnerSubject)
 	if err != nil {
-		ad.logger.Error().Err(err).
+		ad.logger.Error().Ctx(sCtx).Err(err).
 			Str("account_name", req.AccountName).
 			Str("account_number", req.AccountNumber).
 			Msg("failed to create account")
@@ -75,7 +105,7 @@ func (ad *GrpcAdapter) OpenAccount(ctx context.Context, req *pb.BankAccountCreat
 		return nil, StatusCheck(err)
 	}
 
-	ad.logger.Info().
+	ad.logger.Info().Ctx(sCtx).
 		Str("account_uuid", createdAcc.AccountUUID.String()).
 		Str("account_number", createdAcc.AccountNumber).
 		Msg("account created successfully")
@@ -84,42 +114,35 @@ func (ad *GrpcAdapter) OpenAccount(ctx context.Context, req *pb.BankAccountCreat
 		AccountNumber:  createdAcc.AccountNumber,
 		AccountName:    createdAcc.AccountName,
 		Currency:       pb.Currency(pb.Currency_value[createdAcc.Currency]),
-		CurrentBalance: createdAcc.CurrentBalance,
+		CurrentBalance: moneyToPb(createdAcc.CurrentBalance),
+		OverdraftLimit: moneyToPb(createdAcc.OverdraftLimit),
+		Status:         accountStatusToPb(createdAcc.Status),
+		OwnerSubject:   createdAcc.OwnerSubject,
 		CreatedAt:      timestamppb.New(createdAcc.CreatedAt),
 		UpdatedAt:      timestamppb.New(createdAcc.UpdatedAt),
 	}, nil
 }
 
 func (ad *GrpcAdapter) GetCurrentBalance(ctx context.Context, req *pb.CurrentBalanceRequest) (*pb.CurrentBalanceResponse, error) {
-	nlogger := ad.logger.With().Interface("request-id", ctx.Value(RpcCtxRequestIDKey)).Logger()
-	ad.logger = &nlogger
-
 	sCtx, nSpan := otel.Tracer("GetCurrentBalance").Start(ctx, "GetCurrentBalance.span")
 	defer nSpan.End()
 
-	ad.logger.Info().
+	ad.logger.Info().Ctx(sCtx).
 		Str("account_uuid", req.AccountUUID).
 		Msg("received balance check request")
 
 	accUUID, err := uuid.Parse(req.AccountUUID)
 	if err != nil {
-		ad.logger.Error().Err(err).
+		ad.logger.Error().Ctx(sCtx).Err(err).
 			Str("account_uuid", req.AccountUUID).
 			Msg("invalid account UUID format")
-		ad.port.AddError("account_uuid", err.Error())
-	}
-	if !ad.port.Valid() {
-		ad.logger.Error().
-			Interface("validation_errors", ad.port.ValidatorErrors()).
-			Msg("balance check validation failed")
-
 		nSpan.SetStatus(codes.Error, "failed to validate user input")
-		return nil, StatusCheck(ad.port.ValidatorErrors())
+		return nil, StatusCheck(map[string]string{"account_uuid": err.Error()})
 	}
 
-	balance, currency, err := ad.port.GetCurrentBalance(sCtx, accUUID)
+	balance, err := ad.port.GetCurrentBalance(sCtx, accUUID)
 	if err != nil {
-		ad.logger.Error().Err(err).
+		ad.logger.Error().Ctx(sCtx).Err(err).
 			Str("account_uuid", req.AccountUUID).
 			Msg("failed to get account balance")
 
@@ -128,14 +151,13 @@ func (ad *GrpcAdapter) GetCurrentBalance(ctx context.Context, req *pb.CurrentBal
 		return nil, StatusCheck(err)
 	}
 
-	ad.logger.Info().
+	ad.logger.Info().Ctx(sCtx).
 		Str("account_uuid", req.AccountUUID).
-		Float64("balance", balance).
-		Str("currency", currency).
+		Str("balance", balance.String()).
 		Msg("balance retrieved successfully")
 
 	if err := grpc.SetHeader(sCtx, metadata.Pairs("version", "test-v1")); err != nil {
-		ad.logger.Error().Err(err).
+		ad.logger.Error().Ctx(sCtx).Err(err).
 			Msg("couldn't add metadata to the gRPC server response")
 
 		nSpan.RecordError(err)
@@ -145,47 +167,51 @@ func (ad *GrpcAdapter) GetCurrentBalance(ctx context.Context, req *pb.CurrentBal
 
 	return &pb.CurrentBalanceResponse{
 		AccountUUID:    req.AccountUUID,
-		Currency:       pb.Currency(pb.Currency_value[currency]),
-		CurrentBalance: balance,
+		Currency:       pb.Currency(pb.Currency_value[balance.Currency]),
+		CurrentBalance: moneyToPb(balance),
 	}, nil
 }
 
 func (ad *GrpcAdapter) CreateTransaction(ctx context.Context, req *pb.BankTransactionCreateRequest) (*pb.BankTransactionCreateResponse, error) {
-	nlogger := ad.logger.With().Interface("request-id", ctx.Value(RpcCtxRequestIDKey)).Logger()
-	ad.logger = &nlogger
-
 	sCtx, nSpan := otel.Tracer("CreateTransaction").Start(ctx, "CreateTransaction.span")
 	defer nSpan.End()
 
-	ad.logger.Info().
+	ad.logger.Info().Ctx(sCtx).
 		Str("account_uuid", req.AccountUUID).
-		Float64("amount", req.Amount).
+		Str("amount", req.Amount.String()).
 		Str("type", req.TransactionType.String()).
 		Msg("received create transaction request")
 
-	ad.port.Validate(req.Amount >= 0, "transaction_amount", "transaction amount can't be negative")
-	if _, exists := pb.TransactionType_value[req.TransactionType.String()]; !exists {
-		ad.port.Validate(exists, "transaction_type", "unsupported transaction type")
+	violations := make(map[string]string)
+	amount, err := pbToMoney(req.Amount)
+	if err != nil {
+		addViolation(violations, "amount", err.Error())
 	}
 
 	acUUID, err := uuid.Parse(req.AccountUUID)
 	if err != nil {
-		ad.port.AddError("account_uuid", err.Error())
+		addViolation(violations, "account_uuid", err.Error())
+	}
+
+	// the idempotency key may come from the metadata, which isn't covered by the proto field rules
+	idempotencyKey := idempotencyKeyFromRequest(ctx, req.IdempotencyKey)
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		addViolation(violations, "idempotency_key", fmt.Sprintf("idempotency key can't be longer than %d characters", maxIdempotencyKeyLength))
 	}
 
-	if !ad.port.Valid() {
-		ad.logger.Error().
-			Interface("validation_errors", ad.port.ValidatorErrors()).
+	if len(violations) > 0 {
+		ad.logger.Error().Ctx(sCtx).
+			Interface("validation_errors", violations).
 			Msg("transaction creation validation failed")
 		nSpan.SetStatus(codes.Error, "failed to validate user input")
-		return nil, StatusCheck(ad.port.ValidatorErrors())
+		return nil, StatusCheck(violations)
 	}
 
-	nTransaction, err := ad.port.NewTransaction(sCtx, acUUID, req.Amount, req.TransactionType.String(), req.Notes)
+	nTransaction, err := ad.port.NewTransaction(sCtx, acUUID, amount, req.TransactionType.String(), req.Notes, idempotencyKey)
 	if err != nil {
-		ad.logger.Error().Err(err).
+		ad.logger.Error().Ctx(sCtx).Err(err).
 			Str("account_uuid", req.AccountUUID).
-			Float64("amount", req.Amount).
+			Str("amount", amount.String()).
 			Str("type", req.TransactionType.String()).
 			Msg("failed to create transaction")
 		nSpan.RecordError(err)
@@ -193,15 +219,15 @@ func (ad *GrpcAdapter) CreateTransaction(ctx context.Context, req *pb.BankTransa
 		return nil, StatusCheck(err)
 	}
 
-	ad.logger.Info().
+	ad.logger.Info().Ctx(sCtx).
 		Str("transaction_uuid", nTransaction.TransactionUUID.String()).
 		Str("account_uuid", nTransaction.AccountUUID.String()).
-		Float64("amount", nTransaction.Amount).
+		Str("amount", nTransaction.Amount.String()).
 		Msg("transaction created successfully")
 	return &pb.BankTransactionCreateResponse{
 		TransactionUUID:      nTransaction.TransactionUUID.String(),
 		AccountUUID:          nTransaction.AccountUUID.String(),
-		Amount:               nTransaction.Amount,
+		Amount:               moneyToPb(nTransaction.Amount),
 		TransactionType:      pb.TransactionType(pb.TransactionType_value[nTransaction.TransactionType]),
 		Notes:                nTransaction.Notes,
 		TransactionTimestamp: timestamppb.New(nTransaction.TransactionTimestamp),
@@ -211,178 +237,969 @@ func (ad *GrpcAdapter) CreateTransaction(ctx context.Context, req *pb.BankTransa
 }
 
 func (ad *GrpcAdapter) GetExchangeRate(req *pb.ExchangeRateRequest, stream grpc.ServerStreamingServer[pb.ExchangeRateResponse]) error {
-	nlogger := ad.logger.With().Interface("request-id", stream.Context().Value(RpcCtxRequestIDKey)).Logger()
-	ad.logger = &nlogger
-
 	sCtx, nSpan := otel.Tracer("GetExchangeRate").Start(stream.Context(), "GetExchangeRate.span")
 	defer nSpan.End()
 
-	ad.logger.Info().
-		Str("from_currency", req.FromCurrency.String()).
+	openExchangeRateStreams.Add(sCtx, 1)
+	defer openExchangeRateStreams.Add(sCtx, -1)
+
+	ad.logger.Info().Ctx(sCtx).
 		Str("to_currency", req.ToCurrency.String()).
-		Float64("amount", req.Amount).
+		Str("amount", req.Amount.String()).
+		Str("rounding_mode", req.RoundingMode.String()).
 		Msg("started exchange rate stream")
 
-	ad.port.Validate(req.Amount >= 0, "amount", "exchange amount shouldn't be negative")
-	if _, exists := pb.Currency_value[req.FromCurrency.String()]; !exists {
-		ad.port.Validate(!exists, "from_currency", "unsupported currency type")
-	}
-	if _, exists := pb.Currency_value[req.ToCurrency.String()]; !exists {
-		ad.port.Validate(!exists, "to_currency", "unsupported currency type")
-	}
-
-	if !ad.port.Valid() {
-		ad.logger.Error().
-			Interface("validation_errors", ad.port.ValidatorErrors()).
+	amount, err := pbToMoney(req.Amount)
+	if err != nil {
+		ad.logger.Error().Ctx(sCtx).Err(err).
+			Str("amount", req.Amount.String()).
 			Msg("exchange rate validation failed")
 		nSpan.SetStatus(codes.Error, "failed to validate user input")
-		return StatusCheck(ad.port.ValidatorErrors())
+		return StatusCheck(map[string]string{"amount": err.Error()})
 	}
 
-	total, err := ad.port.CalculateRate(sCtx, req.FromCurrency.String(), req.ToCurrency.String(), req.Amount)
+	// a response is sent with the current rate, then only when the rate of the pair changes
+	err = ad.port.WatchRate(sCtx, amount, req.ToCurrency.String(), pbToRoundingMode(req.RoundingMode), func(quote entities.ExchangeRateQuote) error {
+		return stream.Send(&pb.ExchangeRateResponse{
+			Amount:    moneyToPb(quote.Amount),
+			Rate:      quote.Rate,
+			ValidFrom: timestamppb.New(quote.ValidFrom),
+			ValidTo:   timestamppb.New(quote.ValidTo),
+			Sequence:  uint64(quote.Sequence),
+			Path:      rateLegsToPb(quote.Path),
+		})
+	})
+	if stream.Context().Err() != nil {
+		ad.logger.Info().Ctx(sCtx).Msg("client canceled exchange rate stream")
+		return stream.Context().Err()
+	}
 	if err != nil {
-		ad.logger.Error().Err(err).
-			Str("from_currency", req.FromCurrency.String()).
+		ad.logger.Error().Ctx(sCtx).Err(err).
 			Str("to_currency", req.ToCurrency.String()).
-			Float64("amount", req.Amount).
-			Msg("failed to calculate exchange rate")
+			Str("amount", amount.String()).
+			Msg("exchange rate stream failed")
 		nSpan.RecordError(err)
-		nSpan.SetStatus(codes.Error, "failed to calculate exchange rate")
+		nSpan.SetStatus(codes.Error, "exchange rate stream failed")
 		return StatusCheck(err)
 	}
-	resp := &pb.ExchangeRateResponse{
-		Currency: req.ToCurrency.String(),
-		Amount:   total,
-	}
+	return nil
+}
 
-	for {
-		select {
-		case <-stream.Context().Done():
-			ad.logger.Info().Msg("client canceled exchange rate stream")
-			return stream.Context().Err()
-		default:
-			err := stream.Send(resp)
+// SubscribeExchangeRates streams the rates of the pairs subscribed by the client. Every request adds or removes pairs,
+// the current rates of the added pairs are sent right away and the later changes are sent in batches.
+func (ad *GrpcAdapter) SubscribeExchangeRates(stream grpc.BidiStreamingServer[pb.ExchangeRateSubscriptionRequest, pb.ExchangeRateUpdates]) error {
+	sCtx, nSpan := otel.Tracer("SubscribeExchangeRates").Start(stream.Context(), "SubscribeExchangeRates.span")
+	defer nSpan.End()
+
+	openExchangeRateStreams.Add(sCtx, 1)
+	defer openExchangeRateStreams.Add(sCtx, -1)
+
+	ad.logger.Info().Ctx(sCtx).Msg("started exchange rate subscription stream")
+
+	// the requests are read in the background so the updates keep flowing while the client is silent
+	ctx, cancel := context.WithCancelCause(sCtx)
+	defer cancel(nil)
+	changes := make(chan entities.RateSubscriptionChange)
+	go func() {
+		defer close(changes)
+		for {
+			req, err := stream.Recv()
 			if err != nil {
-				ad.logger.Error().Err(err).Msg("failed to send exchange rate response")
-				return StatusCheck(err)
+				if err != io.EOF {
+					cancel(err)
+				}
+				return
 			}
-			time.Sleep(time.Second * 5)
+			select {
+			case changes <- pbToRateSubscriptionChange(req):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	err := ad.port.SubscribeRates(ctx, changes, func(batch entities.ExchangeRateBatch) error {
+		return stream.Send(exchangeRateBatchToPb(batch))
+	})
+	if stream.Context().Err() != nil {
+		ad.logger.Info().Ctx(sCtx).Msg("client canceled exchange rate subscription stream")
+		return stream.Context().Err()
+	}
+	// a failed read, e.g. an invalid request, ends the stream with its own status
+	if cause := context.Cause(ctx); cause != nil && cause != context.Canceled {
+		err = cause
+	}
+	ad.logger.Error().Ctx(sCtx).Err(err).Msg("exchange rate subscription stream failed")
+	nSpan.RecordError(err)
+	nSpan.SetStatus(codes.Error, "exchange rate subscription stream failed")
+	return StatusCheck(err)
+}
+
+func pbToRateSubscriptionChange(req *pb.ExchangeRateSubscriptionRequest) entities.RateSubscriptionChange {
+	pairs := func(pbPairs []*pb.CurrencyPair) []entities.CurrencyPair {
+		result := make([]entities.CurrencyPair, 0, len(pbPairs))
+		for _, p := range pbPairs {
+			result = append(result, entities.CurrencyPair{From: p.From.String(), To: p.To.String()})
 		}
+		return result
 	}
+	currencies := func(pbCurrencies []pb.Currency) []string {
+		result := make([]string, 0, len(pbCurrencies))
+		for _, c := range pbCurrencies {
+			result = append(result, c.String())
+		}
+		return result
+	}
+	return entities.RateSubscriptionChange{
+		AddPairs:    pairs(req.AddPairs),
+		RemovePairs: pairs(req.RemovePairs),
+		AddBases:    currencies(req.AddBases),
+		RemoveBases: currencies(req.RemoveBases),
+	}
+}
+
+func currencyPairToPb(pair entities.CurrencyPair) *pb.CurrencyPair {
+	return &pb.CurrencyPair{
+		From: pb.Currency(pb.Currency_value[pair.From]),
+		To:   pb.Currency(pb.Currency_value[pair.To]),
+	}
+}
+
+func exchangeRateToPb(rate entities.ExchangeRate) *pb.ExchangeRate {
+	return &pb.ExchangeRate{
+		Pair:      currencyPairToPb(rate.Pair),
+		Rate:      rate.Rate,
+		ValidFrom: timestamppb.New(rate.ValidFrom),
+		ValidTo:   timestamppb.New(rate.ValidTo),
+		Sequence:  uint64(rate.Sequence),
+		Path:      rateLegsToPb(rate.Path),
+	}
+}
+
+func rateLegsToPb(path []entities.RateLeg) []*pb.RateLeg {
+	legs := make([]*pb.RateLeg, 0, len(path))
+	for _, leg := range path {
+		legs = append(legs, &pb.RateLeg{
+			Pair:     currencyPairToPb(leg.Pair),
+			Rate:     leg.Rate,
+			Inverted: leg.Inverted,
+			Sequence: uint64(leg.Sequence),
+		})
+	}
+	return legs
+}
+
+func exchangeRateBatchToPb(batch entities.ExchangeRateBatch) *pb.ExchangeRateUpdates {
+	resp := &pb.ExchangeRateUpdates{}
+	for _, rate := range batch.Rates {
+		resp.Rates = append(resp.Rates, exchangeRateToPb(rate))
+	}
+	for _, pair := range batch.Unknown {
+		resp.Unknown = append(resp.Unknown, currencyPairToPb(pair))
+	}
+	return resp
+}
+
+func (ad *GrpcAdapter) GetExchangeRateAsOf(ctx context.Context, req *pb.ExchangeRateAsOfRequest) (*pb.ExchangeRate, error) {
+	sCtx, nSpan := otel.Tracer("GetExchangeRateAsOf").Start(ctx, "GetExchangeRateAsOf.span")
+	defer nSpan.End()
+
+	ad.logger.Info().Ctx(sCtx).
+		Str("from_currency", req.FromCurrency.String()).
+		Str("to_currency", req.ToCurrency.String()).
+		Time("as_of", req.AsOf.AsTime()).
+		Msg("received get exchange rate as of request")
+
+	pair := entities.CurrencyPair{From: req.FromCurrency.String(), To: req.ToCurrency.String()}
+	exRate, err := ad.port.GetRateAsOf(sCtx, pair, req.AsOf.AsTime())
+	if err != nil {
+		ad.logger.Error().Ctx(sCtx).Err(err).
+			Str("from_currency", pair.From).
+			Str("to_currency", pair.To).
+			Msg("failed to get exchange rate as of time")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to get exchange rate as of time")
+		return nil, StatusCheck(err)
+	}
+
+	return exchangeRateToPb(exRate), nil
+}
+
+func (ad *GrpcAdapter) GetExchangeRateHistory(ctx context.Context, req *pb.ExchangeRateHistoryRequest) (*pb.ExchangeRateHistoryResponse, error) {
+	sCtx, nSpan := otel.Tracer("GetExchangeRateHistory").Start(ctx, "GetExchangeRateHistory.span")
+	defer nSpan.End()
+
+	ad.logger.Info().Ctx(sCtx).
+		Str("from_currency", req.FromCurrency.String()).
+		Str("to_currency", req.ToCurrency.String()).
+		Time("start_time", req.StartTime.AsTime()).
+		Time("end_time", req.EndTime.AsTime()).
+		Str("interval", req.Interval.String()).
+		Msg("received get exchange rate history request")
+
+	pair := entities.CurrencyPair{From: req.FromCurrency.String(), To: req.ToCurrency.String()}
+	candles, err := ad.port.GetRateHistory(sCtx, pair, req.StartTime.AsTime(), req.EndTime.AsTime(), rateIntervals[req.Interval])
+	if err != nil {
+		ad.logger.Error().Ctx(sCtx).Err(err).
+			Str("from_currency", pair.From).
+			Str("to_currency", pair.To).
+			Msg("failed to get exchange rate history")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to get exchange rate history")
+		return nil, StatusCheck(err)
+	}
+
+	resp := &pb.ExchangeRateHistoryResponse{
+		Pair:    currencyPairToPb(pair),
+		Candles: make([]*pb.ExchangeRateCandle, 0, len(candles)),
+	}
+	for _, c := range candles {
+		resp.Candles = append(resp.Candles, &pb.ExchangeRateCandle{
+			StartTime: timestamppb.New(c.Start),
+			EndTime:   timestamppb.New(c.End),
+			Open:      c.Open,
+			High:      c.High,
+			Low:       c.Low,
+			Close:     c.Close,
+			Changes:   uint32(c.Changes),
+		})
+	}
+	return resp, nil
+}
+
+var rateIntervals = map[pb.RateInterval]time.Duration{
+	pb.RateInterval_Minute: time.Minute,
+	pb.RateInterval_Hour:   time.Hour,
+	pb.RateInterval_Day:    24 * time.Hour,
 }
 
+// CreateTransfers processes a stream of transfer requests. Every request gets its own response carrying the request correlation id,
+// a failed request is answered with a Failed response and its error instead of terminating the stream.
 func (ad *GrpcAdapter) CreateTransfers(stream grpc.BidiStreamingServer[pb.BankTransferRequest, pb.BankTransferResponse]) error {
 	ctx := stream.Context()
-	nlogger := ad.logger.With().Interface("request-id", ctx.Value(RpcCtxRequestIDKey)).Logger()
-	ad.logger = &nlogger
-
 	sCtx, nSpan := otel.Tracer("CreateTransfers").Start(ctx, "CreateTransfers.span")
 	defer nSpan.End()
 
-	ad.logger.Info().Msg("started bidirectional transfer stream")
+	ad.logger.Info().Ctx(sCtx).Msg("started bidirectional transfer stream")
 
+	var succeeded, failed int
 	for {
 		select {
 		case <-ctx.Done():
-			ad.logger.Info().Msg("client canceled transfer stream")
+			ad.logger.Info().Ctx(sCtx).Msg("client canceled transfer stream")
 			return ctx.Err()
 		default:
 			req, err := stream.Recv()
 			if err != nil {
 				if err == io.EOF {
-					ad.logger.Info().Msg("transfer stream completed")
+					ad.logger.Info().Ctx(sCtx).
+						Int("succeeded", succeeded).
+						Int("failed", failed).
+						Msg("transfer stream completed")
 					return nil
 				}
-				ad.logger.Error().Err(err).Msg("failed to read data from client stream")
+				ad.logger.Error().Ctx(sCtx).Err(err).Msg("failed to read data from client stream")
 				return err
 			}
 
-			ad.logger.Info().
-				Str("from_account", req.FromAccount).
-				Str("to_account", req.ToAccount).
-				Float64("amount", req.Amount).
-				Str("currency", req.Currency.String()).
-				Msg("received transfer request")
-
-			ad.port.Validate(req.Amount >= 0, "amount", "transfer amount shouldn't be a negative number")
-			ad.port.Validate(req.Currency != pb.Currency_Currency_UNSPECEFIED, "currency", "unsupported currency type")
-
-			fromAccountUUID, err := uuid.Parse(req.FromAccount)
-			if err != nil {
-				ad.port.AddError("from_account", err.Error())
-			}
-			toAccountUUID, err := uuid.Parse(req.ToAccount)
-			if err != nil {
-				ad.port.AddError("to_account", err.Error())
-			}
-
-			if !ad.port.Valid() {
-				ad.logger.Error().
-					Interface("validation_errors", ad.port.ValidatorErrors()).
-					Msg("transfer validation failed")
-				nSpan.SetStatus(codes.Error, "failed to validate user input")
-				return StatusCheck(ad.port.ValidatorErrors())
+			resp := ad.transfer(sCtx, req)
+			if resp.TransferStatus == pb.TransferStatus_Failed {
+				failed++
+			} else {
+				succeeded++
 			}
 
-			nTransfer, err := ad.port.TransferMoney(sCtx, fromAccountUUID, toAccountUUID, req.Currency.String(), req.Amount)
+			err = stream.Send(resp)
 			if err != nil {
-				ad.logger.Error().Err(err).
-					Str("from_account", req.FromAccount).
-					Str("to_account", req.ToAccount).
-					Float64("amount", req.Amount).
-					Msg("money transfer failed")
-				nSpan.RecordError(err)
-				nSpan.SetStatus(codes.Error, "failed to transfer money")
+				ad.logger.Error().Ctx(sCtx).Err(err).Msg("failed to send the grpc response to the client")
 				return StatusCheck(err)
 			}
+		}
+	}
+}
+
+// transfer runs a single transfer request of the CreateTransfers stream and builds its response
+func (ad *GrpcAdapter) transfer(ctx context.Context, req *pb.BankTransferRequest) *pb.BankTransferResponse {
+	ad.logger.Info().Ctx(ctx).
+		Str("correlation_id", req.CorrelationID).
+		Str("from_account", req.FromAccount).
+		Str("to_account", req.ToAccount).
+		Str("amount", req.Amount.String()).
+		Msg("received transfer request")
+
+	// stream messages are validated here rather than by the validation interceptor so a bad item doesn't end the stream
+	violations := validateMessage(req)
+	amount, err := pbToMoney(req.Amount)
+	if err != nil {
+		addViolation(violations, "amount", err.Error())
+	}
+	fromAccountUUID, err := uuid.Parse(req.FromAccount)
+	if err != nil {
+		addViolation(violations, "from_account", err.Error())
+	}
+	toAccountUUID, err := uuid.Parse(req.ToAccount)
+	if err != nil {
+		addViolation(violations, "to_account", err.Error())
+	}
+
+	if len(violations) > 0 {
+		ad.logger.Error().Ctx(ctx).
+			Str("correlation_id", req.CorrelationID).
+			Interface("validation_errors", violations).
+			Msg("transfer validation failed")
+		return failedTransferResponse(req, StatusCheck(violations))
+	}
+
+	nTransfer, err := ad.port.TransferMoney(ctx, fromAccountUUID, toAccountUUID, amount, req.IdempotencyKey)
+	if err != nil {
+		ad.logger.Error().Ctx(ctx).Err(err).
+			Str("correlation_id", req.CorrelationID).
+			Str("from_account", req.FromAccount).
+			Str("to_account", req.ToAccount).
+			Str("amount", amount.String()).
+			Msg("money transfer failed")
+		trace.SpanFromContext(ctx).RecordError(err)
+		resp := failedTransferResponse(req, StatusCheck(err))
+		// the failed transfer is recorded when the accounts exist, its history can be looked up with GetTransfer
+		if nTransfer != nil {
+			resp.TransferUUID = nTransfer.TransferUUID.String()
+		}
+		return resp
+	}
+
+	ad.logger.Info().Ctx(ctx).
+		Str("correlation_id", req.CorrelationID).
+		Str("transfer_uuid", nTransfer.TransferUUID.String()).
+		Str("from_account", nTransfer.FromAccountUUID.String()).
+		Str("to_account", nTransfer.ToAccountUUID.String()).
+		Str("amount", nTransfer.Amount.String()).
+		Msg("transfer completed successfully")
+
+	return &pb.BankTransferResponse{
+		CorrelationID:  req.CorrelationID,
+		TransferUUID:   nTransfer.TransferUUID.String(),
+		FromAccount:    nTransfer.FromAccountUUID.String(),
+		ToAccount:      nTransfer.ToAccountUUID.String(),
+		Amount:         moneyToPb(nTransfer.Amount),
+		Time:           timestamppb.New(nTransfer.TransferTimestamp),
+		TransferStatus: transferStatusToPb(nTransfer.Status),
+	}
+}
+
+// failedTransferResponse echoes the request with a Failed status and the error status, including its details, of the failure
+func failedTransferResponse(req *pb.BankTransferRequest, err error) *pb.BankTransferResponse {
+	return &pb.BankTransferResponse{
+		CorrelationID:  req.CorrelationID,
+		FromAccount:    req.FromAccount,
+		ToAccount:      req.ToAccount,
+		Amount:         req.Amount,
+		Time:           timestamppb.Now(),
+		TransferStatus: pb.TransferStatus_Failed,
+		Error:          status.Convert(err).Proto(),
+	}
+}
+
+func (ad *GrpcAdapter) GetTransfer(ctx context.Context, req *pb.GetTransferRequest) (*pb.BankTransfer, error) {
+	sCtx, nSpan := otel.Tracer("GetTransfer").Start(ctx, "GetTransfer.span")
+	defer nSpan.End()
 
-			ad.logger.Info().
-				Str("from_account", nTransfer.FromAccountUUID.String()).
-				Str("to_account", nTransfer.ToAccountUUID.String()).
-				Float64("amount", nTransfer.Amount).
-				Msg("transfer completed successfully")
-
-			resp := &pb.BankTransferResponse{
-				FromAccount:    nTransfer.FromAccountUUID.String(),
-				ToAccount:      nTransfer.ToAccountUUID.String(),
-				Currency:       pb.Currency(pb.Currency_value[nTransfer.Currency]),
-				Amount:         nTransfer.Amount,
-				Time:           timestamppb.New(nTransfer.TransferTimestamp),
-				TransferStatus: pb.TransferStatus_Succes,
+	ad.logger.Info().Ctx(sCtx).
+		Str("transfer_uuid", req.TransferUUID).
+		Msg("received get transfer request")
+
+	trUUID, err := uuid.Parse(req.TransferUUID)
+	if err != nil {
+		nSpan.SetStatus(codes.Error, "failed to validate user input")
+		return nil, StatusCheck(map[string]string{"transfer_uuid": err.Error()})
+	}
+
+	nTransfer, err := ad.port.GetTransfer(sCtx, trUUID)
+	if err != nil {
+		ad.logger.Error().Ctx(sCtx).Err(err).
+			Str("transfer_uuid", req.TransferUUID).
+			Msg("failed to get transfer")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to get transfer")
+		return nil, StatusCheck(err)
+	}
+
+	return transferToPb(nTransfer), nil
+}
+
+func (ad *GrpcAdapter) ListTransfers(ctx context.Context, req *pb.ListTransfersRequest) (*pb.ListTransfersResponse, error) {
+	sCtx, nSpan := otel.Tracer("ListTransfers").Start(ctx, "ListTransfers.span")
+	defer nSpan.End()
+
+	ad.logger.Info().Ctx(sCtx).
+		Str("account_uuid", req.AccountUUID).
+		Str("status", req.Status.String()).
+		Int32("page_size", req.PageSize).
+		Msg("received list transfers request")
+
+	violations := make(map[string]string)
+	filter := &entities.TransferFilter{
+		Status: pbToTransferStatus(req.Status),
+	}
+	if req.AccountUUID != "" {
+		accUUID, err := uuid.Parse(req.AccountUUID)
+		if err != nil {
+			addViolation(violations, "account_uuid", err.Error())
+		}
+		filter.AccountUUID = accUUID
+	}
+	if req.From != nil {
+		filter.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		filter.To = req.To.AsTime()
+	}
+	if req.From != nil && req.To != nil && !filter.From.Before(filter.To) {
+		addViolation(violations, "to", "should be later than from")
+	}
+
+	if len(violations) > 0 {
+		ad.logger.Error().Ctx(sCtx).
+			Interface("validation_errors", violations).
+			Msg("list transfers validation failed")
+		nSpan.SetStatus(codes.Error, "failed to validate user input")
+		return nil, StatusCheck(violations)
+	}
+
+	transfers, nextPageToken, err := ad.port.ListTransfers(sCtx, filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		ad.logger.Error().Ctx(sCtx).Err(err).
+			Str("account_uuid", req.AccountUUID).
+			Msg("failed to list transfers")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to list transfers")
+		return nil, StatusCheck(err)
+	}
+
+	resp := &pb.ListTransfersResponse{
+		Transfers:     make([]*pb.BankTransfer, 0, len(transfers)),
+		NextPageToken: nextPageToken,
+	}
+	for i := range transfers {
+		resp.Transfers = append(resp.Transfers, transferToPb(&transfers[i]))
+	}
+	return resp, nil
+}
+
+func (ad *GrpcAdapter) ReverseTransfer(ctx context.Context, req *pb.ReverseTransferRequest) (*pb.BankTransfer, error) {
+	sCtx, nSpan := otel.Tracer("ReverseTransfer").Start(ctx, "ReverseTransfer.span")
+	defer nSpan.End()
+
+	ad.logger.Info().Ctx(sCtx).
+		Str("transfer_uuid", req.TransferUUID).
+		Str("reason", req.Reason).
+		Msg("received reverse transfer request")
+
+	trUUID, err := uuid.Parse(req.TransferUUID)
+	if err != nil {
+		nSpan.SetStatus(codes.Error, "failed to validate user input")
+		return nil, StatusCheck(map[string]string{"transfer_uuid": err.Error()})
+	}
+
+	nTransfer, err := ad.port.ReverseTransfer(sCtx, trUUID, req.Reason)
+	if err != nil {
+		ad.logger.Error().Ctx(sCtx).Err(err).
+			Str("transfer_uuid", req.TransferUUID).
+			Msg("failed to reverse transfer")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to reverse transfer")
+		return nil, StatusCheck(err)
+	}
+
+	return transferToPb(nTransfer), nil
+}
+
+// transferToPb converts the transfer domain entity, including its status history, to its protobuf message
+func transferToPb(t *entities.BankTransfer) *pb.BankTransfer {
+	transfer := &pb.BankTransfer{
+		TransferUUID:  t.TransferUUID.String(),
+		FromAccount:   t.FromAccountUUID.String(),
+		ToAccount:     t.ToAccountUUID.String(),
+		Amount:        moneyToPb(t.Amount),
+		Status:        transferStatusToPb(t.Status),
+		FailureReason: t.FailureReason,
+		Time:          timestamppb.New(t.TransferTimestamp),
+		CreatedAt:     timestamppb.New(t.CreatedAt),
+		UpdatedAt:     timestamppb.New(t.UpdatedAt),
+		History:       make([]*pb.TransferStatusChange, 0, len(t.History)),
+	}
+	if t.DebitAmount != nil {
+		transfer.DebitAmount = moneyToPb(*t.DebitAmount)
+	}
+	for _, change := range t.History {
+		transfer.History = append(transfer.History, &pb.TransferStatusChange{
+			FromStatus: transferStatusToPb(change.FromStatus),
+			ToStatus:   transferStatusToPb(change.ToStatus),
+			Reason:     change.Reason,
+			ChangedAt:  timestamppb.New(change.ChangedAt),
+		})
+	}
+	return transfer
+}
+
+// transferStatusToPb maps the domain transfer status to its protobuf enum
+func transferStatusToPb(status string) pb.TransferStatus {
+	switch status {
+	case entities.TransferStatusPending:
+		return pb.TransferStatus_Pending
+	case entities.TransferStatusDebited:
+		return pb.TransferStatus_Debited
+	case entities.TransferStatusCompleted:
+		return pb.TransferStatus_Completed
+	case entities.TransferStatusFailed:
+		return pb.TransferStatus_Failed
+	case entities.TransferStatusReversed:
+		return pb.TransferStatus_Reversed
+	default:
+		return pb.TransferStatus_TransferStatus_UNSPECIFIED
+	}
+}
+
+// pbToTransferStatus maps the protobuf transfer status to the domain transfer status. Unspecified maps to an empty status.
+func pbToTransferStatus(status pb.TransferStatus) string {
+	switch status {
+	case pb.TransferStatus_Pending:
+		return entities.TransferStatusPending
+	case pb.TransferStatus_Debited:
+		return entities.TransferStatusDebited
+	case pb.TransferStatus_Completed:
+		return entities.TransferStatusCompleted
+	case pb.TransferStatus_Failed:
+		return entities.TransferStatusFailed
+	case pb.TransferStatus_Reversed:
+		return entities.TransferStatusReversed
+	default:
+		return ""
+	}
+}
+
+func (ad *GrpcAdapter) GetTransaction(ctx context.Context, req *pb.GetTransactionRequest) (*pb.BankTransaction, error) {
+	sCtx, nSpan := otel.Tracer("GetTransaction").Start(ctx, "GetTransaction.span")
+	defer nSpan.End()
+
+	ad.logger.Info().Ctx(sCtx).
+		Str("transaction_uuid", req.TransactionUUID).
+		Msg("received get transaction request")
+
+	trUUID, err := uuid.Parse(req.TransactionUUID)
+	if err != nil {
+		nSpan.SetStatus(codes.Error, "failed to validate user input")
+		return nil, StatusCheck(map[string]string{"transaction_uuid": err.Error()})
+	}
+
+	nTransaction, err := ad.port.GetTransaction(sCtx, trUUID)
+	if err != nil {
+		ad.logger.Error().Ctx(sCtx).Err(err).
+			Str("transaction_uuid", req.TransactionUUID).
+			Msg("failed to get transaction")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to get transaction")
+		return nil, StatusCheck(err)
+	}
+
+	return transactionToPb(nTransaction), nil
+}
+
+func (ad *GrpcAdapter) ListTransactions(ctx context.Context, req *pb.ListTransactionsRequest) (*pb.ListTransactionsResponse, error) {
+	sCtx, nSpan := otel.Tracer("ListTransactions").Start(ctx, "ListTransactions.span")
+	defer nSpan.End()
+
+	ad.logger.Info().Ctx(sCtx).
+		Str("account_uuid", req.AccountUUID).
+		Str("type", req.TransactionType.String()).
+		Int32("page_size", req.PageSize).
+		Msg("received list transactions request")
+
+	violations := make(map[string]string)
+	filter := &entities.TransactionFilter{}
+	if req.AccountUUID != "" {
+		accUUID, err := uuid.Parse(req.AccountUUID)
+		if err != nil {
+			addViolation(violations, "account_uuid", err.Error())
+		}
+		filter.AccountUUID = accUUID
+	}
+	if req.TransactionType != pb.TransactionType_TransactionType_UNKNOWN {
+		filter.TransactionType = req.TransactionType.String()
+	}
+	if req.From != nil {
+		filter.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		filter.To = req.To.AsTime()
+	}
+	if req.From != nil && req.To != nil && !filter.From.Before(filter.To) {
+		addViolation(violations, "to", "should be later than from")
+	}
+	if req.MinAmount != nil {
+		minAmount, err := pbToMoney(req.MinAmount)
+		if err != nil {
+			addViolation(violations, "min_amount", err.Error())
+		}
+		filter.MinAmount = &minAmount
+	}
+	if req.MaxAmount != nil {
+		maxAmount, err := pbToMoney(req.MaxAmount)
+		if err != nil {
+			addViolation(violations, "max_amount", err.Error())
+		}
+		filter.MaxAmount = &maxAmount
+	}
+
+	if len(violations) > 0 {
+		ad.logger.Error().Ctx(sCtx).
+			Interface("validation_errors", violations).
+			Msg("list transactions validation failed")
+		nSpan.SetStatus(codes.Error, "failed to validate user input")
+		return nil, StatusCheck(violations)
+	}
+
+	transactions, nextPageToken, err := ad.port.ListTransactions(sCtx, filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		ad.logger.Error().Ctx(sCtx).Err(err).
+			Str("account_uuid", req.AccountUUID).
+			Msg("failed to list transactions")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to list transactions")
+		return nil, StatusCheck(err)
+	}
+
+	resp := &pb.ListTransactionsResponse{
+		Transactions:  make([]*pb.BankTransaction, 0, len(transactions)),
+		NextPageToken: nextPageToken,
+	}
+	for i := range transactions {
+		resp.Transactions = append(resp.Transactions, transactionToPb(&transactions[i]))
+	}
+	return resp, nil
+}
+
+// transactionToPb converts the transaction domain entity to its protobuf message
+func transactionToPb(t *entities.BankTransaction) *pb.BankTransaction {
+	return &pb.BankTransaction{
+		TransactionUUID:      t.TransactionUUID.String(),
+		AccountUUID:          t.AccountUUID.String(),
+		TransactionType:      pb.TransactionType(pb.TransactionType_value[t.TransactionType]),
+		Amount:               moneyToPb(t.Amount),
+		Notes:                t.Notes,
+		TransactionTimestamp: timestamppb.New(t.TransactionTimestamp),
+		CreatedAt:            timestamppb.New(t.CreatedAt),
+		UpdatedAt:            timestamppb.New(t.UpdatedAt),
+	}
+}
+
+func (ad *GrpcAdapter) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.BankAccount, error) {
+	sCtx, nSpan := otel.Tracer("GetAccount").Start(ctx, "GetAccount.span")
+	defer nSpan.End()
+
+	accUUID, err := uuid.Parse(req.AccountUUID)
+	if err != nil {
+		nSpan.SetStatus(codes.Error, "failed to validate user input")
+		return nil, StatusCheck(map[string]string{"account_uuid": err.Error()})
+	}
+
+	account, err := ad.port.GetAccount(sCtx, accUUID)
+	if err != nil {
+		ad.logger.Error().Ctx(sCtx).Err(err).
+			Str("account_uuid", req.AccountUUID).
+			Msg("failed to get account")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to get account")
+		return nil, StatusCheck(err)
+	}
+	return accountToPb(account), nil
+}
+
+func (ad *GrpcAdapter) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	sCtx, nSpan := otel.Tracer("ListAccounts").Start(ctx, "ListAccounts.span")
+	defer nSpan.End()
+
+	ad.logger.Info().Ctx(sCtx).
+		Str("currency", req.Currency.String()).
+		Str("name", req.Name).
+		Str("status", req.Status.String()).
+		Int32("page_size", req.PageSize).
+		Msg("received list accounts request")
+
+	filter := &entities.AccountFilter{
+		Name:   req.Name,
+		Status: pbToAccountStatus(req.Status),
+	}
+	if req.Currency != pb.Currency_Currency_UNSPECEFIED {
+		filter.Currency = req.Currency.String()
+	}
+
+	accounts, nextPageToken, err := ad.port.ListAccounts(sCtx, filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		ad.logger.Error().Ctx(sCtx).Err(err).Msg("failed to list accounts")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to list accounts")
+		return nil, StatusCheck(err)
+	}
+
+	resp := &pb.ListAccountsResponse{
+		Accounts:      make([]*pb.BankAccount, 0, len(accounts)),
+		NextPageToken: nextPageToken,
+	}
+	for i := range accounts {
+		resp.Accounts = append(resp.Accounts, accountToPb(&accounts[i]))
+	}
+	return resp, nil
+}
+
+func (ad *GrpcAdapter) UpdateAccount(ctx context.Context, req *pb.UpdateAccountRequest) (*pb.BankAccount, error) {
+	sCtx, nSpan := otel.Tracer("UpdateAccount").Start(ctx, "UpdateAccount.span")
+	defer nSpan.End()
+
+	ad.logger.Info().Ctx(sCtx).
+		Str("account_uuid", req.AccountUUID).
+		Strs("update_mask", req.UpdateMask.GetPaths()).
+		Msg("received update account request")
+
+	violations := make(map[string]string)
+	accUUID, err := uuid.Parse(req.AccountUUID)
+	if err != nil {
+		addViolation(violations, "account_uuid", err.Error())
+	}
+
+	update := &entities.AccountUpdate{}
+	for _, path := range req.UpdateMask.GetPaths() {
+		switch path {
+		case "account_name", "AccountName":
+			name := req.Account.GetAccountName()
+			if name == "" || len(name) > 100 {
+				addViolation(violations, "account.account_name", "account name should be between 1 and 100 characters")
 			}
-			err = stream.Send(resp)
+			update.AccountName = &name
+		case "overdraft_limit", "OverdraftLimit":
+			limit, err := pbToMoney(req.Account.GetOverdraftLimit())
 			if err != nil {
-				ad.logger.Error().Err(err).Msg("failed to send the grpc response to the client")
-				return StatusCheck(err)
+				addViolation(violations, "account.overdraft_limit", err.Error())
+			} else if limit.IsNegative() {
+				addViolation(violations, "account.overdraft_limit", "overdraft limit shouldn't be a negative number")
 			}
+			update.OverdraftLimit = &limit
+		default:
+			addViolation(violations, "update_mask", fmt.Sprintf("unsupported update path %q", path))
+		}
+	}
+	if len(req.UpdateMask.GetPaths()) == 0 {
+		addViolation(violations, "update_mask", "update mask should select at least one field")
+	}
+
+	if len(violations) > 0 {
+		ad.logger.Error().Ctx(sCtx).
+			Interface("validation_errors", violations).
+			Msg("account update validation failed")
+		nSpan.SetStatus(codes.Error, "failed to validate user input")
+		return nil, StatusCheck(violations)
+	}
+
+	account, err := ad.port.UpdateAccount(sCtx, accUUID, update)
+	if err != nil {
+		ad.logger.Error().Ctx(sCtx).Err(err).
+			Str("account_uuid", req.AccountUUID).
+			Msg("failed to update account")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to update account")
+		return nil, StatusCheck(err)
+	}
+	return accountToPb(account), nil
+}
+
+func (ad *GrpcAdapter) FreezeAccount(ctx context.Context, req *pb.AccountStatusRequest) (*pb.BankAccount, error) {
+	return ad.changeAccountStatus(ctx, req, "FreezeAccount", ad.port.FreezeAccount)
+}
+
+func (ad *GrpcAdapter) UnfreezeAccount(ctx context.Context, req *pb.AccountStatusRequest) (*pb.BankAccount, error) {
+	return ad.changeAccountStatus(ctx, req, "UnfreezeAccount", ad.port.UnfreezeAccount)
+}
+
+func (ad *GrpcAdapter) CloseAccount(ctx context.Context, req *pb.AccountStatusRequest) (*pb.BankAccount, error) {
+	return ad.changeAccountStatus(ctx, req, "CloseAccount", ad.port.CloseAccount)
+}
+
+// changeAccountStatus runs one of the account lifecycle operations which only take the account uuid
+func (ad *GrpcAdapter) changeAccountStatus(ctx context.Context, req *pb.AccountStatusRequest, operation string, fn func(context.Context, uuid.UUID) (*entities.BankAccount, error)) (*pb.BankAccount, error) {
+	sCtx, nSpan := otel.Tracer(operation).Start(ctx, operation+".span")
+	defer nSpan.End()
+
+	ad.logger.Info().Ctx(sCtx).
+		Str("account_uuid", req.AccountUUID).
+		Str("operation", operation).
+		Msg("received account status change request")
+
+	accUUID, err := uuid.Parse(req.AccountUUID)
+	if err != nil {
+		nSpan.SetStatus(codes.Error, "failed to validate user input")
+		return nil, StatusCheck(map[string]string{"account_uuid": err.Error()})
+	}
+
+	account, err := fn(sCtx, accUUID)
+	if err != nil {
+		ad.logger.Error().Ctx(sCtx).Err(err).
+			Str("account_uuid", req.AccountUUID).
+			Str("operation", operation).
+			Msg("failed to change account status")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to change account status")
+		return nil, StatusCheck(err)
+	}
+	return accountToPb(account), nil
+}
+
+// accountToPb converts the bank account domain entity to its protobuf message
+func accountToPb(a *entities.BankAccount) *pb.BankAccount {
+	account := &pb.BankAccount{
+		AccountUUID:    a.AccountUUID.String(),
+		AccountNumber:  a.AccountNumber,
+		AccountName:    a.AccountName,
+		Currency:       pb.Currency(pb.Currency_value[a.Currency]),
+		CurrentBalance: moneyToPb(a.CurrentBalance),
+		OverdraftLimit: moneyToPb(a.OverdraftLimit),
+		Status:         accountStatusToPb(a.Status),
+		OwnerSubject:   a.OwnerSubject,
+		CreatedAt:      timestamppb.New(a.CreatedAt),
+		UpdatedAt:      timestamppb.New(a.UpdatedAt),
+	}
+	if !a.ClosedAt.IsZero() {
+		account.ClosedAt = timestamppb.New(a.ClosedAt)
+	}
+	return account
+}
+
+// accountStatusToPb maps the domain account status to its protobuf enum
+func accountStatusToPb(status string) pb.AccountStatus {
+	switch status {
+	case entities.AccountStatusActive:
+		return pb.AccountStatus_Active
+	case entities.AccountStatusFrozen:
+		return pb.AccountStatus_Frozen
+	case entities.AccountStatusClosed:
+		return pb.AccountStatus_Closed
+	default:
+		return pb.AccountStatus_AccountStatus_UNSPECIFIED
+	}
+}
+
+// pbToAccountStatus maps the protobuf account status to the domain account status. Unspecified maps to an empty status.
+func pbToAccountStatus(status pb.AccountStatus) string {
+	switch status {
+	case pb.AccountStatus_Active:
+		return entities.AccountStatusActive
+	case pb.AccountStatus_Frozen:
+		return entities.AccountStatusFrozen
+	case pb.AccountStatus_Closed:
+		return entities.AccountStatusClosed
+	default:
+		return ""
+	}
+}
+
+func (ad *GrpcAdapter) VerifyLedger(ctx context.Context, req *pb.VerifyLedgerRequest) (*pb.VerifyLedgerResponse, error) {
+	sCtx, nSpan := otel.Tracer("VerifyLedger").Start(ctx, "VerifyLedger.span")
+	defer nSpan.End()
+
+	ad.logger.Info().Ctx(sCtx).Msg("received verify ledger request")
+
+	report, err := ad.port.VerifyLedger(sCtx)
+	if err != nil {
+		ad.logger.Error().Ctx(sCtx).Err(err).Msg("failed to verify ledger")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to verify ledger")
+		return nil, StatusCheck(err)
+	}
+
+	resp := &pb.VerifyLedgerResponse{
+		Balanced:          report.Balanced(),
+		AccountsChecked:   int64(report.AccountsChecked),
+		Mismatches:        make([]*pb.LedgerBalanceMismatch, 0, len(report.Mismatches)),
+		UnbalancedEntries: make([]*pb.UnbalancedJournalEntry, 0, len(report.UnbalancedEntries)),
+		CheckedAt:         timestamppb.New(report.CheckedAt),
+	}
+	for _, m := range report.Mismatches {
+		mismatch := &pb.LedgerBalanceMismatch{
+			AccountUUID:   m.AccountUUID.String(),
+			StoredBalance: moneyToPb(m.StoredBalance),
+			LedgerBalance: moneyToPb(m.LedgerBalance),
 		}
+		if difference, err := m.StoredBalance.Add(m.LedgerBalance.Neg()); err == nil {
+			mismatch.Difference = moneyToPb(difference)
+		}
+		resp.Mismatches = append(resp.Mismatches, mismatch)
+	}
+	for _, e := range report.UnbalancedEntries {
+		resp.UnbalancedEntries = append(resp.UnbalancedEntries, &pb.UnbalancedJournalEntry{
+			EntryUUID: e.EntryUUID.String(),
+			Imbalance: moneyToPb(e.Imbalance),
+		})
 	}
+	return resp, nil
 }
 
-func NewGrpcAdapter(grpcHost string, grpcPort string, logger *zerolog.Logger, port GrpcPortReference) *GrpcAdapter {
+// NewGrpcAdapter creates the gRPC server. A nil tlsCfg serves plaintext, which is only meant for local development.
+func NewGrpcAdapter(grpcHost string, grpcPort string, logger *zerolog.Logger, port GrpcPortReference, tlsCfg *TLSConfig, authn Authenticator, limiter *RateLimiter) (*GrpcAdapter, error) {
 	otelHandler := otelgrpc.NewServerHandler()
+	// the rate limiter trusts the client ip forwarded by the http gateway only on the connections of this listener
+	gatewayListener := newInProcessListener()
+	unaryInterceptors := []grpc.UnaryServerInterceptor{requestIDGenerator(), recoveryUnaryInterceptor(logger)}
+	streamInterceptors := []grpc.StreamServerInterceptor{requestIDStreamInterceptor(), recoveryStreamInterceptor(logger)}
+	if authn != nil {
+		unaryInterceptors = append(unaryInterceptors, authUnaryInterceptor(authn, logger), authzUnaryInterceptor(logger))
+		streamInterceptors = append(streamInterceptors, authStreamInterceptor(authn, logger), authzStreamInterceptor(logger))
+	} else {
+		logger.Warn().Msg("grpc server authentication is disabled, every request is accepted")
+	}
+	if limiter != nil {
+		unaryInterceptors = append(unaryInterceptors, rateLimitUnaryInterceptor(limiter, gatewayListener, logger))
+		streamInterceptors = append(streamInterceptors, rateLimitStreamInterceptor(limiter, gatewayListener, logger))
+	}
+	unaryInterceptors = append(unaryInterceptors, logReqUnaryInterceptor(logger), validationUnaryInterceptor(logger))
+	streamInterceptors = append(streamInterceptors, validationStreamInterceptor(logger))
+
 	opts := []grpc.ServerOption{
 		grpc.StatsHandler(otelHandler),
-		grpc.ChainUnaryInterceptor(
-			requestIDGenerator(),
-			logReqUnaryInterceptor(logger),
-		),
-		grpc.ChainStreamInterceptor(
-			BasicStreamServerInterceptor(),
-		),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+	inProcessSrv := newInProcessServer(opts...)
+
+	var reloader *certReloader
+	if tlsCfg != nil {
+		var err error
+		reloader, err = newCertReloader(*tlsCfg, logger)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to load the grpc server tls configuration")
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(reloader.credentials()))
+	} else {
+		logger.Warn().Msg("grpc server tls is disabled, serving plaintext")
 	}
 	srv := grpc.NewServer(opts...)
 
 	ad := &GrpcAdapter{
-		port:     port,
-		grpcPort: grpcPort,
-		grpcHost: grpcHost,
-		logger:   logger,
-		Srv:      srv,
+		port:         port,
+		grpcPort:     grpcPort,
+		grpcHost:     grpcHost,
+		logger:       logger,
+		Srv:          srv,
+		certReloader: reloader,
+		health:       newHealthMonitor(port.HealthGrpcPort, logger),
+		rateLimiter:  limiter,
+
+		inProcessSrv:      inProcessSrv,
+		inProcessListener: gatewayListener,
 	}
 	pb.RegisterBankServiceServer(srv, ad)
+	pb.RegisterBankServiceServer(inProcessSrv, ad)
+	healthpb.RegisterHealthServer(srv, ad.health.srv)
 	reflection.Register(srv)
-	return ad
+	return ad, nil
 
 }
 
@@ -394,6 +1211,12 @@ func (ad *GrpcAdapter) Run() error {
 	}
 
 	ad.logger.Info().Msgf("starting grpc server on %s:%s", ad.grpcHost, ad.grpcPort)
+	go ad.health.run()
+	go func() {
+		if err := ad.inProcessSrv.Serve(ad.inProcessListener); err != nil {
+			ad.logger.Error().Err(err).Msg("in-process grpc server failed to serve")
+		}
+	}()
 	err = ad.Srv.Serve(listenAddr)
 	if err != nil {
 		ad.logger.Error().Err(err).Msg("server failed to serve")
@@ -404,20 +1227,46 @@ func (ad *GrpcAdapter) Run() error {
 }
 
 func (ad *GrpcAdapter) Stop(ctx context.Context) error {
-	ad.logger.Info().Msg("gracefully stopping gRPC server")
+	ad.logger.Info().Ctx(ctx).Msg("gracefully stopping gRPC server")
+	// report NOT_SERVING first so load balancers stop routing new requests while the pending ones drain
+	ad.health.shutdown()
 
 	stopped := make(chan error)
 	go func() {
 		ad.Srv.GracefulStop()
+		ad.inProcessSrv.GracefulStop()
 		close(stopped)
 	}()
 
 	select {
 	case <-stopped:
-		ad.logger.Info().Msg("gRPC server stopped gracefully")
+		ad.logger.Info().Ctx(ctx).Msg("gRPC server stopped gracefully")
 	case <-ctx.Done():
-		ad.logger.Warn().Msg("timeout during graceful shutdown, forcing gRPC server to stop")
+		ad.logger.Warn().Ctx(ctx).Msg("timeout during graceful shutdown, forcing gRPC server to stop")
 		ad.Srv.Stop()
+		ad.inProcessSrv.Stop()
+	}
+
+	if ad.rateLimiter != nil {
+		if err := ad.rateLimiter.Close(); err != nil {
+			return err
+		}
+	}
+	if ad.certReloader != nil {
+		return ad.certReloader.Close()
 	}
 	return nil
 }
+
+// idempotencyKeyFromRequest returns the idempotency key of the request message, falling back to the idempotency-key metadata
+func idempotencyKeyFromRequest(ctx context.Context, reqKey string) string {
+	if reqKey != "" {
+		return reqKey
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if keys := md.Get(IdempotencyKeyMetadata); len(keys) > 0 {
+			return keys[0]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,138 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// AuthorizationMetadata carries a "Bearer <jwt>" token
+	AuthorizationMetadata = "authorization"
+	// APIKeyMetadata carries a static api key
+	APIKeyMetadata = "x-api-key"
+)
+
+// publicMethods are served without credentials so orchestrators can probe the server
+var publicMethods = map[string]bool{
+	healthpb.Health_Check_FullMethodName: true,
+	healthpb.Health_Watch_FullMethodName: true,
+}
+
+// errNoCredentials is returned by an authenticator when the request doesn't carry its kind of credentials
+var errNoCredentials = errors.New("no credentials")
+
+// Authenticator verifies the credentials carried by the request metadata and returns the authenticated principal.
+// It returns errNoCredentials when the request carries no credentials it understands.
+type Authenticator interface {
+	Authenticate(ctx context.Context, md metadata.MD) (*domains.Principal, error)
+}
+
+// AuthConfig selects the authenticators of the gRPC server. Every configured authenticator is tried in turn.
+type AuthConfig struct {
+	JWKSFile    string // JSON Web Key Set verifying HS and RS signed JWTs
+	JWTIssuer   string // expected iss claim, not checked when empty
+	JWTAudience string // expected aud claim, not checked when empty
+	APIKeysFile string // static api keys
+}
+
+// chainAuthenticator tries its authenticators in order until one finds credentials in the request
+type chainAuthenticator []Authenticator
+
+// NewAuthenticator builds the authenticators selected by the configuration
+func NewAuthenticator(cfg AuthConfig) (Authenticator, error) {
+	var chain chainAuthenticator
+	if cfg.JWKSFile != "" {
+		jwtAuth, err := newJWTAuthenticator(cfg.JWKSFile, cfg.JWTIssuer, cfg.JWTAudience)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, jwtAuth)
+	}
+	if cfg.APIKeysFile != "" {
+		apiKeyAuth, err := newAPIKeyAuthenticator(cfg.APIKeysFile)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, apiKeyAuth)
+	}
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("no authenticator is configured, set a JWKS file or an api keys file")
+	}
+	return chain, nil
+}
+
+func (c chainAuthenticator) Authenticate(ctx context.Context, md metadata.MD) (*domains.Principal, error) {
+	for _, authn := range c {
+		principal, err := authn.Authenticate(ctx, md)
+		if errors.Is(err, errNoCredentials) {
+			continue
+		}
+		return principal, err
+	}
+	return nil, errNoCredentials
+}
+
+// authenticate runs the authenticator on the incoming metadata and returns the context carrying the principal.
+// The returned error is the Unauthenticated status sent to the client, the cause is only logged.
+func authenticate(ctx context.Context, authn Authenticator, method string, logger *zerolog.Logger) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	principal, err := authn.Authenticate(ctx, md)
+	if err != nil {
+		logger.Warn().Ctx(ctx).
+			Err(err).
+			Str("grpc_method", method).
+			Msg("request authentication failed")
+		if errors.Is(err, errNoCredentials) {
+			return nil, status.Error(codes.Unauthenticated, "missing credentials")
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	}
+	return domains.ContextWithPrincipal(ctx, principal), nil
+}
+
+// authUnaryInterceptor rejects unary requests without valid credentials and stores the principal in the request context
+func authUnaryInterceptor(authn Authenticator, logger *zerolog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		if publicMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		aCtx, err := authenticate(ctx, authn, info.FullMethod, logger)
+		if err != nil {
+			return nil, err
+		}
+		return handler(aCtx, req)
+	}
+}
+
+// authStreamInterceptor rejects streams without valid credentials and stores the principal in the stream context
+func authStreamInterceptor(authn Authenticator, logger *zerolog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if publicMethods[info.FullMethod] {
+			return handler(srv, ss)
+		}
+		aCtx, err := authenticate(ss.Context(), authn, info.FullMethod, logger)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: aCtx})
+	}
+}
+
+// contextServerStream overrides the context of a server stream
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,81 @@
+package adapters
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	"google.golang.org/grpc/metadata"
+)
+
+// apiKeyEntry is a static api key of the api keys file. Only the SHA-256 of the key is stored,
+// e.g. generated with: printf '%s' "$KEY" | sha256sum
+type apiKeyEntry struct {
+	Name      string   `json:"name"`
+	KeySHA256 string   `json:"key_sha256"`
+	Subject   string   `json:"subject"`
+	Roles     []string `json:"roles"`
+}
+
+// apiKeyAuthenticator verifies static api keys from a config file
+type apiKeyAuthenticator struct {
+	keys []apiKeyEntry
+}
+
+func newAPIKeyAuthenticator(apiKeysFile string) (*apiKeyAuthenticator, error) {
+	raw, err := os.ReadFile(apiKeysFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the api keys file: %w", err)
+	}
+	var cfg struct {
+		Keys []apiKeyEntry `json:"keys"`
+	}
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse the api keys file: %w", err)
+	}
+	for i, key := range cfg.Keys {
+		hash, err := hex.DecodeString(key.KeySHA256)
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("api key %q should have a hex encoded key_sha256", key.Name)
+		}
+		if key.Subject == "" {
+			return nil, fmt.Errorf("api key %q has no subject", key.Name)
+		}
+		cfg.Keys[i].KeySHA256 = strings.ToLower(key.KeySHA256)
+	}
+	if len(cfg.Keys) == 0 {
+		return nil, fmt.Errorf("api keys file %s doesn't contain any key", apiKeysFile)
+	}
+	return &apiKeyAuthenticator{keys: cfg.Keys}, nil
+}
+
+func (aa *apiKeyAuthenticator) Authenticate(ctx context.Context, md metadata.MD) (*domains.Principal, error) {
+	values := md.Get(APIKeyMetadata)
+	if len(values) == 0 || values[0] == "" {
+		return nil, errNoCredentials
+	}
+
+	sum := sha256.Sum256([]byte(values[0]))
+	hash := hex.EncodeToString(sum[:])
+	// every key is compared so the time taken doesn't reveal which key matched
+	var match *apiKeyEntry
+	for i := range aa.keys {
+		if subtle.ConstantTimeCompare([]byte(hash), []byte(aa.keys[i].KeySHA256)) == 1 {
+			match = &aa.keys[i]
+		}
+	}
+	if match == nil {
+		return nil, fmt.Errorf("unknown api key")
+	}
+	return &domains.Principal{
+		Subject:    match.Subject,
+		Roles:      match.Roles,
+		AuthMethod: domains.AuthMethodAPIKey,
+	}, nil
+}
@@ -0,0 +1,152 @@
+package adapters
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/metadata"
+)
+
+// jwtClaims are the claims read from the bearer tokens
+type jwtClaims struct {
+	Roles []string `json:"roles"`
+	jwt.RegisteredClaims
+}
+
+// jsonWebKey holds the fields of a RFC 7517 key used by HS (kty oct) and RS (kty RSA) signatures
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	K   string `json:"k"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// jwtAuthenticator verifies HS and RS signed bearer tokens against the keys of a local JWKS file
+type jwtAuthenticator struct {
+	hmacKeys map[string][]byte
+	rsaKeys  map[string]*rsa.PublicKey
+	parser   *jwt.Parser
+}
+
+func newJWTAuthenticator(jwksFile string, issuer string, audience string) (*jwtAuthenticator, error) {
+	raw, err := os.ReadFile(jwksFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the JWKS file: %w", err)
+	}
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(raw, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to parse the JWKS file: %w", err)
+	}
+
+	ja := &jwtAuthenticator{
+		hmacKeys: make(map[string][]byte),
+		rsaKeys:  make(map[string]*rsa.PublicKey),
+	}
+	for _, key := range jwks.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		switch key.Kty {
+		case "oct":
+			secret, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(key.K, "="))
+			if err != nil || len(secret) == 0 {
+				return nil, fmt.Errorf("invalid oct key %q in the JWKS file", key.Kid)
+			}
+			ja.hmacKeys[key.Kid] = secret
+		case "RSA":
+			pub, err := rsaPublicKey(key)
+			if err != nil {
+				return nil, fmt.Errorf("invalid RSA key %q in the JWKS file: %w", key.Kid, err)
+			}
+			ja.rsaKeys[key.Kid] = pub
+		default:
+			return nil, fmt.Errorf("unsupported key type %q of key %q in the JWKS file", key.Kty, key.Kid)
+		}
+	}
+	if len(ja.hmacKeys)+len(ja.rsaKeys) == 0 {
+		return nil, fmt.Errorf("JWKS file %s doesn't contain any signing key", jwksFile)
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"HS256", "HS384", "HS512", "RS256", "RS384", "RS512"}),
+		jwt.WithExpirationRequired(),
+	}
+	if issuer != "" {
+		opts = append(opts, jwt.WithIssuer(issuer))
+	}
+	if audience != "" {
+		opts = append(opts, jwt.WithAudience(audience))
+	}
+	ja.parser = jwt.NewParser(opts...)
+	return ja, nil
+}
+
+func (ja *jwtAuthenticator) Authenticate(ctx context.Context, md metadata.MD) (*domains.Principal, error) {
+	values := md.Get(AuthorizationMetadata)
+	if len(values) == 0 {
+		return nil, errNoCredentials
+	}
+	scheme, token, found := strings.Cut(values[0], " ")
+	if !found || !strings.EqualFold(scheme, "bearer") {
+		return nil, errNoCredentials
+	}
+
+	claims := &jwtClaims{}
+	if _, err := ja.parser.ParseWithClaims(strings.TrimSpace(token), claims, ja.keyFunc); err != nil {
+		return nil, err
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("token has no subject")
+	}
+	return &domains.Principal{
+		Subject:    claims.Subject,
+		Roles:      claims.Roles,
+		AuthMethod: domains.AuthMethodJWT,
+	}, nil
+}
+
+// keyFunc picks the verification key by the kid header. The key type must match the signing method
+// so an RSA public key can never be used as an HMAC secret.
+func (ja *jwtAuthenticator) keyFunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if key, exists := ja.hmacKeys[kid]; exists {
+			return key, nil
+		}
+	case *jwt.SigningMethodRSA:
+		if key, exists := ja.rsaKeys[kid]; exists {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("no %s key with kid %q", token.Method.Alg(), kid)
+}
+
+func rsaPublicKey(key jsonWebKey) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(key.N, "="))
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(key.E, "="))
+	if err != nil {
+		return nil, err
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if len(n) == 0 || !exponent.IsInt64() || exponent.Int64() < 3 {
+		return nil, fmt.Errorf("invalid modulus or exponent")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
@@ -0,0 +1,90 @@
+package adapters
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/cybrarymin/gRPC/protogen/pb"
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+)
+
+var (
+	anyRole   = []string{domains.RoleCustomer, domains.RoleTeller, domains.RoleAdmin}
+	staffRole = []string{domains.RoleTeller, domains.RoleAdmin}
+	adminRole = []string{domains.RoleAdmin}
+)
+
+// methodRoles declares the roles allowed to call each BankService method. Methods missing from the policy are denied.
+// The policy only decides who may call a method, the domain services decide which accounts a customer may reach.
+var methodRoles = map[string][]string{
+	pb.BankService_OpenAccount_FullMethodName:            anyRole,
+	pb.BankService_CreateTransaction_FullMethodName:      staffRole, // deposits and withdrawals
+	pb.BankService_GetCurrentBalance_FullMethodName:      anyRole,
+	pb.BankService_GetExchangeRate_FullMethodName:        anyRole,
+	pb.BankService_SubscribeExchangeRates_FullMethodName: anyRole,
+	pb.BankService_GetExchangeRateAsOf_FullMethodName:    anyRole,
+	pb.BankService_GetExchangeRateHistory_FullMethodName: anyRole,
+	pb.BankService_CreateTransfers_FullMethodName:        anyRole,
+	pb.BankService_GetTransaction_FullMethodName:         anyRole,
+	pb.BankService_ListTransactions_FullMethodName:       anyRole,
+	pb.BankService_GetAccount_FullMethodName:             anyRole,
+	pb.BankService_ListAccounts_FullMethodName:           anyRole,
+	pb.BankService_UpdateAccount_FullMethodName:          anyRole,
+	pb.BankService_FreezeAccount_FullMethodName:          staffRole,
+	pb.BankService_UnfreezeAccount_FullMethodName:        staffRole,
+	pb.BankService_CloseAccount_FullMethodName:           adminRole,
+	pb.BankService_GetTransfer_FullMethodName:            anyRole,
+	pb.BankService_ListTransfers_FullMethodName:          anyRole,
+	pb.BankService_ReverseTransfer_FullMethodName:        adminRole,
+	pb.BankService_VerifyLedger_FullMethodName:           adminRole,
+}
+
+// authorizeMethod checks the principal of the request holds one of the roles the policy allows for the method.
+// Methods of other services, such as reflection, only require an authenticated caller.
+func authorizeMethod(ctx context.Context, method string, logger *zerolog.Logger) error {
+	if !strings.HasPrefix(method, "/"+pb.BankService_ServiceDesc.ServiceName+"/") {
+		return nil
+	}
+
+	principal := domains.PrincipalFromContext(ctx)
+	roles, exists := methodRoles[method]
+	if principal != nil && exists && slices.ContainsFunc(roles, principal.HasRole) {
+		return nil
+	}
+
+	subject := ""
+	if principal != nil {
+		subject = principal.Subject
+	}
+	logger.Warn().Ctx(ctx).
+		Str("grpc_method", method).
+		Str("subject", subject).
+		Msg("request denied by the method policy")
+	return permissionDenied("caller role doesn't allow calling "+method, "ROLE_REQUIRED", map[string]string{
+		"method":         method,
+		"required_roles": strings.Join(roles, ","),
+	})
+}
+
+// authzUnaryInterceptor enforces the method policy on unary requests. It must run after the authentication interceptor.
+func authzUnaryInterceptor(logger *zerolog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		if err := authorizeMethod(ctx, info.FullMethod, logger); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// authzStreamInterceptor enforces the method policy on streams. It must run after the authentication interceptor.
+func authzStreamInterceptor(logger *zerolog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorizeMethod(ss.Context(), info.FullMethod, logger); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,84 @@
+package adapters
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cybrarymin/gRPC/protogen/pb"
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/ports"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// healthCheckInterval is the time between two readiness checks of the server dependencies
+const healthCheckInterval = 5 * time.Second
+
+// healthMonitor serves grpc.health.v1 and keeps the status of the server and of BankService in line with the readiness of its dependencies.
+// The empty service name reports the status of the whole server.
+type healthMonitor struct {
+	srv      *health.Server
+	port     domains.HealthGrpcPort
+	logger   *zerolog.Logger
+	stop     chan struct{}
+	stopOnce sync.Once
+	status   healthpb.HealthCheckResponse_ServingStatus // last status, only touched by the run loop
+}
+
+func newHealthMonitor(port domains.HealthGrpcPort, logger *zerolog.Logger) *healthMonitor {
+	m := &healthMonitor{
+		srv:    health.NewServer(),
+		port:   port,
+		logger: logger,
+		stop:   make(chan struct{}),
+	}
+	// nothing is served until the first readiness check passes
+	m.srv.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	m.srv.SetServingStatus(pb.BankService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	return m
+}
+
+// run checks the readiness periodically until shutdown is called
+func (m *healthMonitor) run() {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		m.check()
+		select {
+		case <-m.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *healthMonitor) check() {
+	err := m.port.CheckReadiness(context.Background())
+	status := healthpb.HealthCheckResponse_SERVING
+	if err != nil {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	if status == m.status {
+		return
+	}
+
+	m.status = status
+	m.srv.SetServingStatus("", status)
+	m.srv.SetServingStatus(pb.BankService_ServiceDesc.ServiceName, status)
+	if err != nil {
+		m.logger.Error().Err(err).Msg("readiness check failed, grpc server is not serving")
+		return
+	}
+	m.logger.Info().Msg("readiness check passed, grpc server is serving")
+}
+
+// shutdown stops the readiness checks and reports every service as NOT_SERVING for good,
+// so the clients stop sending new requests while the server drains
+func (m *healthMonitor) shutdown() {
+	m.stopOnce.Do(func() {
+		close(m.stop)
+		m.srv.Shutdown()
+	})
+}
@@ -0,0 +1,123 @@
+package adapters
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// newInProcessServer creates a plaintext grpc server listening in memory. It runs the same interceptors as the public server
+// so the requests coming through the http gateway are authenticated, authorized, rate limited and validated the same way.
+func newInProcessServer(opts ...grpc.ServerOption) *grpc.Server {
+	return grpc.NewServer(opts...)
+}
+
+// DialInProcess returns a client connection to the in-memory grpc server, used by the http gateway to forward its requests
+func (ad *GrpcAdapter) DialInProcess() (*grpc.ClientConn, error) {
+	return grpc.NewClient("passthrough:///"+ad.inProcessListener.Addr().String(),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return ad.inProcessListener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+}
+
+// inProcessListener hands in-memory connections to the grpc server serving the http gateway.
+// The connections it accepts report the listener itself as their address, so a peer is recognized as the gateway
+// by the listener instance it came through and never by a name another transport could report.
+type inProcessListener struct {
+	conns     chan net.Conn
+	done      chan struct{}
+	closeOnce sync.Once
+	addr      *inProcessAddr
+}
+
+func newInProcessListener() *inProcessListener {
+	l := &inProcessListener{
+		conns: make(chan net.Conn),
+		done:  make(chan struct{}),
+	}
+	l.addr = &inProcessAddr{listener: l}
+	return l
+}
+
+func (l *inProcessListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.done:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *inProcessListener) Close() error {
+	l.closeOnce.Do(func() { close(l.done) })
+	return nil
+}
+
+func (l *inProcessListener) Addr() net.Addr {
+	return l.addr
+}
+
+// DialContext connects to the listener, the connection is established once the server accepts it
+func (l *inProcessListener) DialContext(ctx context.Context) (net.Conn, error) {
+	server, client := net.Pipe()
+	select {
+	case l.conns <- &inProcessConn{Conn: server, addr: l.addr}:
+		return &inProcessConn{Conn: client, addr: l.addr}, nil
+	case <-l.done:
+		server.Close()
+		client.Close()
+		return nil, net.ErrClosed
+	case <-ctx.Done():
+		server.Close()
+		client.Close()
+		return nil, ctx.Err()
+	}
+}
+
+// accepted reports whether the peer address belongs to a connection accepted by the listener
+func (l *inProcessListener) accepted(addr net.Addr) bool {
+	a, ok := addr.(*inProcessAddr)
+	return ok && l != nil && a.listener == l
+}
+
+// inProcessAddr is the address of both ends of the in-memory connections of a listener
+type inProcessAddr struct {
+	listener *inProcessListener
+}
+
+func (a *inProcessAddr) Network() string {
+	return "inprocess"
+}
+
+func (a *inProcessAddr) String() string {
+	return "gateway"
+}
+
+// inProcessConn is an in-memory connection reporting the address of its listener, the pipe has no address of its own
+type inProcessConn struct {
+	net.Conn
+	addr *inProcessAddr
+}
+
+func (c *inProcessConn) LocalAddr() net.Addr {
+	return c.addr
+}
+
+func (c *inProcessConn) RemoteAddr() net.Addr {
+	return c.addr
+}
+
+// GatewayTLSConfig returns the tls configuration the http gateway serves with, sharing the reloaded certificates of the grpc server.
+// It returns nil when the grpc server serves plaintext.
+func (ad *GrpcAdapter) GatewayTLSConfig() *tls.Config {
+	if ad.certReloader == nil {
+		return nil
+	}
+	return ad.certReloader.httpConfig()
+}
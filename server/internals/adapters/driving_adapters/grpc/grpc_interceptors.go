@@ -7,6 +7,7 @@ import (
 	"github.com/cybrarymin/gRPC/protogen/pb"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
@@ -17,19 +18,76 @@ var (
 	RpcCtxRequestIDKey RpcReqID = "request_id"
 )
 
+const (
+	// RequestIDMetadata is the metadata key carrying the request id, the caller may set it and the server always sends it back in the response headers
+	RequestIDMetadata  = "x-request-id"
+	maxRequestIDLength = 128
+)
+
+// requestIDFromMetadata returns the request id sent by the caller, or a new one when the caller didn't send a usable id
+func requestIDFromMetadata(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(RequestIDMetadata); len(ids) > 0 && validRequestID(ids[0]) {
+			return ids[0]
+		}
+	}
+	return uuid.New().String()
+}
+
+// validRequestID accepts the short printable ascii ids so a caller can't inject anything odd into the logs
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		if c < 0x21 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
 func requestIDGenerator() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
-		reqID := uuid.New()
-		nCtx := context.WithValue(ctx, RpcCtxRequestIDKey, reqID.String())
+		reqID := requestIDFromMetadata(ctx)
+		nCtx := context.WithValue(ctx, RpcCtxRequestIDKey, reqID)
+		// the header is sent along with the response or the error, so a failed call can be reported with its id too
+		grpc.SetHeader(nCtx, metadata.Pairs(RequestIDMetadata, reqID))
 		return handler(nCtx, req)
 	}
 }
 
+// requestIDStreamInterceptor gives every stream a request id the same way requestIDGenerator does for the unary calls
+func requestIDStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		reqID := requestIDFromMetadata(ss.Context())
+		ss.SetHeader(metadata.Pairs(RequestIDMetadata, reqID))
+		return handler(srv, &contextServerStream{
+			ServerStream: ss,
+			ctx:          context.WithValue(ss.Context(), RpcCtxRequestIDKey, reqID),
+		})
+	}
+}
+
+// RequestLogHook adds the request id, trace id and span id of the event context to the log events.
+// Every layer logging with .Ctx(ctx) during a request gets the fields, which correlates the log lines with the request traces.
+type RequestLogHook struct{}
+
+func (RequestLogHook) Run(e *zerolog.Event, level zerolog.Level, msg string) {
+	ctx := e.GetCtx()
+	if reqID, ok := ctx.Value(RpcCtxRequestIDKey).(string); ok {
+		e.Str("request_id", reqID)
+	}
+	if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.IsValid() {
+		e.Str("trace_id", spanCtx.TraceID().String()).
+			Str("span_id", spanCtx.SpanID().String())
+	}
+}
+
 func logReqUnaryInterceptor(logger *zerolog.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
 		names := strings.Split(info.FullMethod, "/")
-		logger.Info().
-			Interface("request_id", ctx.Value(RpcCtxRequestIDKey)).
+		logger.Info().Ctx(ctx).
 			Str("grpc_service", names[1]).
 			Str("grpc_method", names[2]).
 			Interface("request_info", req).
@@ -76,62 +134,9 @@ func ExampleBaiscUnaryServerInterceptor(logger *zerolog.Logger) grpc.UnaryServer
 		// In case you want to change anything from within the response u should check for the resp type first
 		switch response := resp.(type) {
 		case *pb.CurrentBalanceResponse:
-			response.CurrentBalance += 10
+			response.AccountUUID = strings.ToLower(response.AccountUUID)
 		}
 
 		return resp, nil
 	}
 }
-
-func BasicStreamServerInterceptor() grpc.StreamServerInterceptor {
-	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-		// Create a wrapper around the server stream to intercept messages
-		wrappedStream := &wrappedServerStream{
-			ServerStream: ss,
-		}
-
-		// handler will use two function of the stream which is stream.RecvMsg and stream.SendMsg.
-		// If we use ss.RecvMsg() directly we recieve a message from client and we are fully responsible for processing of that message and we should map that message to specific gRPC method and so on.
-		// moreover when we use ss.RecvMsg() directly handler won't have that message anymore.
-		// because of this we use a new type and create two new methods of RecvMsg and SendMsg then our handler will be able to use those calls and we are also able to process request streams or respons streams
-		return handler(srv, wrappedStream)
-	}
-}
-
-// wrappedServerStream wraps grpc.ServerStream to allow intercepting stream messages
-type wrappedServerStream struct {
-	grpc.ServerStream
-}
-
-// RecvMsg intercepts incoming messages
-func (w *wrappedServerStream) RecvMsg(msg interface{}) error {
-	if err := w.ServerStream.RecvMsg(msg); err != nil {
-		return err
-	}
-
-	// Here you can modify the received message
-	// You need to type assert to the specific message type
-	switch m := msg.(type) {
-	case *pb.BankTransferRequest:
-		// Modify request fields as needed
-		reqMeta, exists := metadata.FromIncomingContext(w.Context())
-		if exists {
-			reqMeta.Set("test-stream-interceptor", m.Currency.String())
-		}
-		w.SetHeader(reqMeta)
-	}
-
-	return nil
-}
-
-// SendMsg intercepts outgoing messages
-func (w *wrappedServerStream) SendMsg(msg interface{}) error {
-	// Here you can modify the response message before sending
-	switch m := msg.(type) {
-	case *pb.BankTransferResponse:
-		// Modify response fields as needed
-		m.Amount += 100
-	}
-
-	return w.ServerStream.SendMsg(msg)
-}
@@ -0,0 +1,16 @@
+package adapters
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// The rpc latency and error metrics by method and status code, e.g. rpc_server_duration_milliseconds, are recorded by the otelgrpc stats handler.
+// The instruments below cover what the stats handler can't see.
+var (
+	meter = otel.Meter("github.com/cybrarymin/gRPC/server/internals/adapters/driving_adapters/grpc")
+
+	openExchangeRateStreams, _ = meter.Int64UpDownCounter("bank.exchange_rate.streams.open",
+		metric.WithDescription("Number of exchange rate streams currently open"),
+		metric.WithUnit("{stream}"))
+)
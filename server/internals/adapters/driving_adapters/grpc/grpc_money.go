@@ -0,0 +1,42 @@
+package adapters
+
+import (
+	"github.com/cybrarymin/gRPC/protogen/pb"
+	entities "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	domainErrors "github.com/cybrarymin/gRPC/server/internals/domains/errors"
+)
+
+// pbToMoney converts the protobuf money message to the exact money domain type
+func pbToMoney(m *pb.Money) (entities.Money, error) {
+	if m == nil {
+		return entities.Money{}, domainErrors.InvalidInputError("amount is required")
+	}
+	if m.Currency == pb.Currency_Currency_UNSPECEFIED {
+		return entities.Money{}, domainErrors.InvalidCurrencyError(m.Currency.String())
+	}
+	return entities.MoneyFromUnits(m.Units, m.Nanos, m.Currency.String())
+}
+
+// moneyToPb converts the money domain type to its protobuf message
+func moneyToPb(m entities.Money) *pb.Money {
+	units, nanos := m.Units()
+	return &pb.Money{
+		Currency: pb.Currency(pb.Currency_value[m.Currency]),
+		Units:    units,
+		Nanos:    nanos,
+	}
+}
+
+// pbToRoundingMode maps the protobuf rounding mode to the domain rounding mode. Unspecified falls back to half-even.
+func pbToRoundingMode(mode pb.RoundingMode) entities.RoundingMode {
+	switch mode {
+	case pb.RoundingMode_HalfUp:
+		return entities.RoundHalfUp
+	case pb.RoundingMode_Down:
+		return entities.RoundDown
+	case pb.RoundingMode_Up:
+		return entities.RoundUp
+	default:
+		return entities.RoundHalfEven
+	}
+}
@@ -0,0 +1,373 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// rateLimitIdleTTL is the time after which the buckets of a silent client are dropped
+	rateLimitIdleTTL = 10 * time.Minute
+	// streamLimitRetryDelay is the retry delay suggested to a client holding too many streams
+	streamLimitRetryDelay = time.Second
+)
+
+// rateLimit is a token bucket refilled with RPS tokens per second and holding at most Burst tokens
+type rateLimit struct {
+	RPS   float64 `json:"rps"`
+	Burst int     `json:"burst"`
+}
+
+// rateLimitRules are the limits applied to each client. Every RPC takes a token from the bucket of its method,
+// or from the default bucket when the method has no limit of its own. Unset limits aren't enforced.
+type rateLimitRules struct {
+	Default              *rateLimit            `json:"default"`
+	Methods              map[string]*rateLimit `json:"methods"` // keyed by full method name, e.g. /bank.BankService/CreateTransaction
+	MaxConcurrentStreams int                   `json:"max_concurrent_streams"`
+}
+
+// rateLimitConfig is the content of the rate limit file. Clients overrides the rules of a principal subject or a peer ip.
+//
+//	{
+//	  "default": {"rps": 20, "burst": 40},
+//	  "methods": {"/bank.BankService/CreateTransaction": {"rps": 2, "burst": 5}},
+//	  "max_concurrent_streams": 5,
+//	  "clients": {"batch-service": {"default": {"rps": 200, "burst": 400}}}
+//	}
+type rateLimitConfig struct {
+	rateLimitRules
+	Clients map[string]rateLimitRules `json:"clients"`
+}
+
+// limitFor returns the limit of the method for the client, or nil when the method isn't limited,
+// along with the bucket name: the method itself, or an empty name for the default bucket shared by the unlisted methods
+func (c *rateLimitConfig) limitFor(client string, method string) (*rateLimit, string) {
+	if override, exists := c.Clients[client]; exists {
+		if limit := override.Methods[method]; limit != nil {
+			return limit, method
+		}
+		if override.Default != nil {
+			return override.Default, ""
+		}
+	}
+	if limit := c.Methods[method]; limit != nil {
+		return limit, method
+	}
+	return c.Default, ""
+}
+
+// maxStreamsFor returns the number of streams the client may hold open at once, zero means unlimited
+func (c *rateLimitConfig) maxStreamsFor(client string) int {
+	if override, exists := c.Clients[client]; exists && override.MaxConcurrentStreams > 0 {
+		return override.MaxConcurrentStreams
+	}
+	return c.MaxConcurrentStreams
+}
+
+func (c *rateLimitConfig) validate() error {
+	check := func(where string, rules rateLimitRules) error {
+		limits := map[string]*rateLimit{"default": rules.Default}
+		for method, limit := range rules.Methods {
+			limits[method] = limit
+		}
+		for name, limit := range limits {
+			if limit != nil && (limit.RPS <= 0 || limit.Burst <= 0) {
+				return fmt.Errorf("%s limit %s should have a positive rps and burst", where, name)
+			}
+		}
+		if rules.MaxConcurrentStreams < 0 {
+			return fmt.Errorf("%s max_concurrent_streams can't be negative", where)
+		}
+		return nil
+	}
+	if err := check("global", c.rateLimitRules); err != nil {
+		return err
+	}
+	for client, rules := range c.Clients {
+		if err := check("client "+client, rules); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type bucketKey struct {
+	client string
+	method string
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimiter throttles the RPCs of each client, identified by its principal subject or its peer ip,
+// and caps the number of streams a client holds open. The limits are reloaded whenever the config file changes.
+type RateLimiter struct {
+	file    string
+	logger  *zerolog.Logger
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+
+	mu      sync.Mutex
+	cfg     *rateLimitConfig
+	buckets map[bucketKey]*bucket
+	streams map[string]int
+}
+
+// NewRateLimiter loads the limits of the config file and starts watching it for changes
+func NewRateLimiter(file string, logger *zerolog.Logger) (*RateLimiter, error) {
+	rl := &RateLimiter{
+		file:    file,
+		logger:  logger,
+		done:    make(chan struct{}),
+		buckets: make(map[bucketKey]*bucket),
+		streams: make(map[string]int),
+	}
+	if err := rl.reload(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch the rate limit file: %w", err)
+	}
+	// the directory is watched rather than the file so atomic renames and kubernetes configmap symlink swaps are noticed
+	if err := watcher.Add(filepath.Dir(file)); err != nil {
+		watcher.Close()
+		return nil, fmt.Errorf("failed to watch %s: %w", filepath.Dir(file), err)
+	}
+	rl.watcher = watcher
+	go rl.watch()
+	go rl.evictIdle()
+
+	return rl, nil
+}
+
+// reload parses the config file and swaps it in. The buckets start over so the new limits apply right away.
+// On failure the previous limits stay in use.
+func (rl *RateLimiter) reload() error {
+	raw, err := os.ReadFile(rl.file)
+	if err != nil {
+		return fmt.Errorf("failed to read the rate limit file: %w", err)
+	}
+	cfg := &rateLimitConfig{}
+	if err := json.Unmarshal(raw, cfg); err != nil {
+		return fmt.Errorf("failed to parse the rate limit file: %w", err)
+	}
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+
+	rl.mu.Lock()
+	rl.cfg = cfg
+	rl.buckets = make(map[bucketKey]*bucket)
+	rl.mu.Unlock()
+	return nil
+}
+
+func (rl *RateLimiter) watch() {
+	for {
+		select {
+		case event, ok := <-rl.watcher.Events:
+			if !ok {
+				return
+			}
+			name := filepath.Base(event.Name)
+			if filepath.Clean(event.Name) != filepath.Clean(rl.file) && name != "..data" {
+				continue
+			}
+			if err := rl.reload(); err != nil {
+				rl.logger.Warn().Err(err).Str("file", event.Name).Msg("failed to reload rate limits, keeping the current limits")
+				continue
+			}
+			rl.logger.Info().Str("file", event.Name).Msg("reloaded rate limits")
+		case err, ok := <-rl.watcher.Errors:
+			if !ok {
+				return
+			}
+			rl.logger.Error().Err(err).Msg("rate limit file watcher failed")
+		}
+	}
+}
+
+// evictIdle drops the buckets of the clients which haven't called for a while so the map doesn't grow with every peer ever seen
+func (rl *RateLimiter) evictIdle() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-rl.done:
+			return
+		case now := <-ticker.C:
+			rl.mu.Lock()
+			for key, b := range rl.buckets {
+				if now.Sub(b.lastSeen) > rateLimitIdleTTL {
+					delete(rl.buckets, key)
+				}
+			}
+			rl.mu.Unlock()
+		}
+	}
+}
+
+// allow takes a token for the call. When the bucket is empty it returns false with the time until the next token.
+func (rl *RateLimiter) allow(client string, method string) (bool, time.Duration) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	limit, bucketName := rl.cfg.limitFor(client, method)
+	if limit == nil {
+		return true, 0
+	}
+	key := bucketKey{client: client, method: bucketName}
+	b, exists := rl.buckets[key]
+	if !exists {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.RPS), limit.Burst)}
+		rl.buckets[key] = b
+	}
+	b.lastSeen = time.Now()
+
+	reservation := b.limiter.Reserve()
+	if delay := reservation.Delay(); delay > 0 {
+		reservation.Cancel()
+		return false, delay
+	}
+	return true, 0
+}
+
+// acquireStream counts a new stream of the client. It returns false when the client already holds the maximum number of streams.
+func (rl *RateLimiter) acquireStream(client string) bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if max := rl.cfg.maxStreamsFor(client); max > 0 && rl.streams[client] >= max {
+		return false
+	}
+	rl.streams[client]++
+	return true
+}
+
+func (rl *RateLimiter) releaseStream(client string) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rl.streams[client]--
+	if rl.streams[client] <= 0 {
+		delete(rl.streams, client)
+	}
+}
+
+// Close stops watching the config file
+func (rl *RateLimiter) Close() error {
+	close(rl.done)
+	return rl.watcher.Close()
+}
+
+// rateLimitClient identifies the caller by its principal subject, or by its peer ip for unauthenticated calls.
+// The calls of the http gateway, received through the gateway listener, are identified by the remote ip the gateway appends to x-forwarded-for.
+func rateLimitClient(ctx context.Context, gateway *inProcessListener) string {
+	if principal := domains.PrincipalFromContext(ctx); principal != nil {
+		return principal.Subject
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if gateway.accepted(p.Addr) {
+			return forwardedClient(ctx)
+		}
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			return p.Addr.String()
+		}
+		return host
+	}
+	return ""
+}
+
+// forwardedClient returns the last x-forwarded-for entry, which is the remote ip seen by the gateway.
+// The former entries are set by the http client and can't be trusted.
+func forwardedClient(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	forwarded := md.Get("x-forwarded-for")
+	if len(forwarded) == 0 {
+		return ""
+	}
+	hops := strings.Split(forwarded[len(forwarded)-1], ",")
+	return strings.TrimSpace(hops[len(hops)-1])
+}
+
+// resourceExhausted returns a ResourceExhausted status telling the client when to retry
+func resourceExhausted(msg string, retryDelay time.Duration) error {
+	st := status.New(codes.ResourceExhausted, msg)
+	stwithdetails, attacherr := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryDelay),
+	})
+	if attacherr != nil {
+		return status.Error(codes.Internal, "couldn't attach error details to the status")
+	}
+	return stwithdetails.Err()
+}
+
+// rateLimitUnaryInterceptor throttles unary calls. It runs after the authentication interceptor to know the principal.
+func rateLimitUnaryInterceptor(rl *RateLimiter, gateway *inProcessListener, logger *zerolog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		if publicMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		client := rateLimitClient(ctx, gateway)
+		if allowed, retryDelay := rl.allow(client, info.FullMethod); !allowed {
+			logger.Warn().Ctx(ctx).
+				Str("grpc_method", info.FullMethod).
+				Str("client", client).
+				Dur("retry_delay", retryDelay).
+				Msg("request rate limited")
+			return nil, resourceExhausted("rate limit exceeded", retryDelay)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// rateLimitStreamInterceptor throttles the opening of streams and caps the streams a client holds open at once
+func rateLimitStreamInterceptor(rl *RateLimiter, gateway *inProcessListener, logger *zerolog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if publicMethods[info.FullMethod] {
+			return handler(srv, ss)
+		}
+		client := rateLimitClient(ss.Context(), gateway)
+		if allowed, retryDelay := rl.allow(client, info.FullMethod); !allowed {
+			logger.Warn().Ctx(ss.Context()).
+				Str("grpc_method", info.FullMethod).
+				Str("client", client).
+				Dur("retry_delay", retryDelay).
+				Msg("stream rate limited")
+			return resourceExhausted("rate limit exceeded", retryDelay)
+		}
+		if !rl.acquireStream(client) {
+			logger.Warn().Ctx(ss.Context()).
+				Str("grpc_method", info.FullMethod).
+				Str("client", client).
+				Msg("too many concurrent streams")
+			return resourceExhausted("too many concurrent streams", streamLimitRetryDelay)
+		}
+		defer rl.releaseStream(client)
+		return handler(srv, ss)
+	}
+}
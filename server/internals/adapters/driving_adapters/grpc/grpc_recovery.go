@@ -0,0 +1,63 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recoveryUnaryInterceptor turns a panic of the interceptors after it or of the handler into an Internal error
+// so a single faulty request can't crash the whole server
+func recoveryUnaryInterceptor(logger *zerolog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if panicValue := recover(); panicValue != nil {
+				resp, err = nil, recoverPanic(ctx, info.FullMethod, panicValue, logger)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// recoveryStreamInterceptor turns a panic of the interceptors after it or of the stream handler into an Internal error
+func recoveryStreamInterceptor(logger *zerolog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if panicValue := recover(); panicValue != nil {
+				err = recoverPanic(ss.Context(), info.FullMethod, panicValue, logger)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+// recoverPanic logs the panic with its stack, records it on the request span and returns the status sent to the client.
+// The panic value may hold internal details so the client only gets the request id to report the failure.
+func recoverPanic(ctx context.Context, method string, panicValue any, logger *zerolog.Logger) error {
+	stack := string(debug.Stack())
+	panicErr := fmt.Errorf("panic: %v", panicValue)
+	span := trace.SpanFromContext(ctx)
+	requestID := ctx.Value(RpcCtxRequestIDKey)
+
+	logger.Error().Ctx(ctx).
+		Err(panicErr).
+		Str("grpc_method", method).
+		Str("stack", stack).
+		Msg("recovered from a panic in the grpc handler")
+
+	span.RecordError(panicErr, trace.WithAttributes(attribute.String("exception.stacktrace", stack)))
+	span.SetStatus(otelcodes.Error, "handler panicked")
+
+	if requestID != nil {
+		return status.Errorf(codes.Internal, "internal server error, request id %v", requestID)
+	}
+	return status.Error(codes.Internal, "internal server error")
+}
@@ -1,6 +1,7 @@
 package adapters
 
 import (
+	"github.com/cybrarymin/gRPC/protogen/pb"
 	domainErrors "github.com/cybrarymin/gRPC/server/internals/domains/errors"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
@@ -33,11 +34,61 @@ func StatusCheck(err interface{}) error {
 			return status.Error(codes.AlreadyExists, e.Error())
 		case domainErrors.IsNotFound(e):
 			return status.Error(codes.NotFound, e.Error())
-		case domainErrors.IsInvalidInput(e):
+		case domainErrors.IsInvalidInput(e), domainErrors.IsInvalidCurrency(e):
 			return status.Error(codes.InvalidArgument, e.Error())
+		case domainErrors.IsInsufficientBalance(e):
+			return preconditionFailure(e, "INSUFFICIENT_BALANCE", "bank_account", "debit exceeds the account balance plus its overdraft limit")
+		case domainErrors.IsIdempotencyKeyReused(e):
+			return preconditionFailure(e, "IDEMPOTENCY_KEY_REUSED", "idempotency_key", "idempotency key was already used with a different request payload")
+		case domainErrors.IsAccountFrozen(e):
+			return preconditionFailure(e, "ACCOUNT_FROZEN", "bank_account", "frozen accounts don't accept debits")
+		case domainErrors.IsAccountClosed(e):
+			return preconditionFailure(e, "ACCOUNT_CLOSED", "bank_account", "closed accounts don't accept any change")
+		case domainErrors.IsAccountBalanceNotZero(e):
+			return preconditionFailure(e, "ACCOUNT_BALANCE_NOT_ZERO", "bank_account", "only accounts with a zero balance can be closed")
+		case domainErrors.IsInvalidStatusTransition(e):
+			return preconditionFailure(e, "INVALID_STATUS_TRANSITION", "bank_transfer", "the current transfer status doesn't allow this change")
+		case domainErrors.IsStaleExchangeRate(e):
+			return preconditionFailure(e, "EXCHANGE_RATE_STALE", "exchange_rate", "the latest rate of the currency pair has expired")
+		case domainErrors.IsNotAccountOwner(e):
+			return permissionDenied(e.Error(), "NOT_ACCOUNT_OWNER", nil)
+		case domainErrors.IsRoleRequired(e):
+			return permissionDenied(e.Error(), "ROLE_REQUIRED", nil)
 		default:
 			return status.Error(codes.Internal, e.Error())
 		}
 	}
 	return nil
 }
+
+// preconditionFailure returns a FailedPrecondition status carrying a single precondition violation
+func preconditionFailure(err error, violationType string, subject string, description string) error {
+	st := status.New(codes.FailedPrecondition, err.Error())
+	stwithdetails, attacherr := st.WithDetails(&errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{
+			{
+				Type:        violationType,
+				Subject:     subject,
+				Description: description,
+			},
+		},
+	})
+	if attacherr != nil {
+		return status.Error(codes.Internal, "couldn't attach error details to the status")
+	}
+	return stwithdetails.Err()
+}
+
+// permissionDenied returns a PermissionDenied status carrying the reason of the denial as an ErrorInfo
+func permissionDenied(msg string, reason string, metadata map[string]string) error {
+	st := status.New(codes.PermissionDenied, msg)
+	stwithdetails, attacherr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   pb.BankService_ServiceDesc.ServiceName,
+		Metadata: metadata,
+	})
+	if attacherr != nil {
+		return status.Error(codes.Internal, "couldn't attach error details to the status")
+	}
+	return stwithdetails.Err()
+}
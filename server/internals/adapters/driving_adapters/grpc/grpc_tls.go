@@ -0,0 +1,196 @@
+package adapters
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/credentials"
+)
+
+// TLSConfig configures the transport security of the gRPC server
+type TLSConfig struct {
+	CertFile          string
+	KeyFile           string
+	ClientCAFile      string // clients presenting a certificate are verified against this CA bundle
+	RequireClientCert bool   // reject clients without a certificate signed by ClientCAFile (mutual TLS)
+}
+
+// certReloader serves the server certificate and the client CA bundle from memory and reloads them
+// whenever their files change, so rotated certificates are picked up without restarting the server.
+type certReloader struct {
+	cfg     TLSConfig
+	logger  *zerolog.Logger
+	watcher *fsnotify.Watcher
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+}
+
+func newCertReloader(cfg TLSConfig, logger *zerolog.Logger) (*certReloader, error) {
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, fmt.Errorf("both the tls certificate and key files are required")
+	}
+	if cfg.RequireClientCert && cfg.ClientCAFile == "" {
+		return nil, fmt.Errorf("a client CA file is required to enforce client certificates")
+	}
+
+	cr := &certReloader{
+		cfg:    cfg,
+		logger: logger,
+	}
+	if err := cr.reload(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch the tls files: %w", err)
+	}
+	// directories are watched rather than the files so atomic renames and kubernetes secret symlink swaps are noticed
+	watched := make(map[string]bool)
+	for _, file := range cr.files() {
+		dir := filepath.Dir(file)
+		if watched[dir] {
+			continue
+		}
+		if err := watcher.Add(dir); err != nil {
+			watcher.Close()
+			return nil, fmt.Errorf("failed to watch %s: %w", dir, err)
+		}
+		watched[dir] = true
+	}
+	cr.watcher = watcher
+	go cr.watch()
+
+	return cr, nil
+}
+
+// files returns the paths of the configured tls files
+func (cr *certReloader) files() []string {
+	files := []string{cr.cfg.CertFile, cr.cfg.KeyFile}
+	if cr.cfg.ClientCAFile != "" {
+		files = append(files, cr.cfg.ClientCAFile)
+	}
+	return files
+}
+
+// reload reads the tls files and swaps them in. On failure the previously loaded files stay in use.
+func (cr *certReloader) reload() error {
+	cert, err := tls.LoadX509KeyPair(cr.cfg.CertFile, cr.cfg.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load the tls certificate: %w", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if cr.cfg.ClientCAFile != "" {
+		pem, err := os.ReadFile(cr.cfg.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read the client CA file: %w", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("client CA file %s doesn't contain any PEM certificate", cr.cfg.ClientCAFile)
+		}
+	}
+
+	cr.mu.Lock()
+	cr.cert = &cert
+	cr.clientCAs = clientCAs
+	cr.mu.Unlock()
+	return nil
+}
+
+func (cr *certReloader) watch() {
+	for {
+		select {
+		case event, ok := <-cr.watcher.Events:
+			if !ok {
+				return
+			}
+			if !cr.isTLSFileEvent(event) {
+				continue
+			}
+			if err := cr.reload(); err != nil {
+				// files are often written in several steps, the next event reloads the complete files
+				cr.logger.Warn().Err(err).Str("file", event.Name).Msg("failed to reload tls files, keeping the current certificate")
+				continue
+			}
+			cr.logger.Info().Str("file", event.Name).Msg("reloaded tls certificate")
+		case err, ok := <-cr.watcher.Errors:
+			if !ok {
+				return
+			}
+			cr.logger.Error().Err(err).Msg("tls file watcher failed")
+		}
+	}
+}
+
+// isTLSFileEvent reports whether the event concerns one of the tls files or the symlinked data directory of a kubernetes secret
+func (cr *certReloader) isTLSFileEvent(event fsnotify.Event) bool {
+	name := filepath.Base(event.Name)
+	if strings.HasPrefix(name, "..data") {
+		return true
+	}
+	for _, file := range cr.files() {
+		if filepath.Clean(event.Name) == filepath.Clean(file) {
+			return true
+		}
+	}
+	return false
+}
+
+// configForClient builds the tls configuration of each handshake from the currently loaded files
+func (cr *certReloader) configForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	cr.mu.RLock()
+	defer cr.mu.RUnlock()
+
+	cfg := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{*cr.cert},
+		NextProtos:   []string{"h2"},
+		ClientAuth:   tls.NoClientCert,
+	}
+	if cr.clientCAs != nil {
+		cfg.ClientCAs = cr.clientCAs
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+		if cr.cfg.RequireClientCert {
+			cfg.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+	return cfg, nil
+}
+
+// credentials returns the grpc transport credentials backed by the reloader
+func (cr *certReloader) credentials() credentials.TransportCredentials {
+	return credentials.NewTLS(&tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		GetConfigForClient: cr.configForClient,
+	})
+}
+
+// httpConfig returns the tls configuration of the http gateway backed by the reloader
+func (cr *certReloader) httpConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			cfg, err := cr.configForClient(hello)
+			if err != nil {
+				return nil, err
+			}
+			cfg.NextProtos = []string{"h2", "http/1.1"}
+			return cfg, nil
+		},
+	}
+}
+
+func (cr *certReloader) Close() error {
+	return cr.watcher.Close()
+}
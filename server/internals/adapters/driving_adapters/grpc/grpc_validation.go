@@ -0,0 +1,198 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/cybrarymin/gRPC/protogen/pb"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// patternCache keeps the compiled regular expressions of the (Rules).Pattern field options
+var patternCache sync.Map
+
+// validationUnaryInterceptor enforces the field rules declared in the proto files on every unary request.
+// Each call builds its own list of violations so one invalid request never affects another one.
+func validationUnaryInterceptor(logger *zerolog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		if msg, ok := req.(proto.Message); ok {
+			if violations := validateMessage(msg); len(violations) > 0 {
+				logger.Error().Ctx(ctx).
+					Str("grpc_method", info.FullMethod).
+					Interface("validation_errors", violations).
+					Msg("request validation failed")
+				return nil, StatusCheck(violations)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// perItemValidationMethods are the streaming methods which validate each received message themselves
+// and answer invalid messages with a failed response instead of terminating the stream.
+var perItemValidationMethods = map[string]bool{
+	pb.BankService_CreateTransfers_FullMethodName: true,
+}
+
+// validationStreamInterceptor enforces the field rules declared in the proto files on every message received from a client stream
+func validationStreamInterceptor(logger *zerolog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if perItemValidationMethods[info.FullMethod] {
+			return handler(srv, ss)
+		}
+		return handler(srv, &validatingServerStream{
+			ServerStream: ss,
+			method:       info.FullMethod,
+			logger:       logger,
+		})
+	}
+}
+
+// validatingServerStream validates each received message before handing it to the stream handler
+type validatingServerStream struct {
+	grpc.ServerStream
+	method string
+	logger *zerolog.Logger
+}
+
+func (w *validatingServerStream) RecvMsg(msg interface{}) error {
+	if err := w.ServerStream.RecvMsg(msg); err != nil {
+		return err
+	}
+	if m, ok := msg.(proto.Message); ok {
+		if violations := validateMessage(m); len(violations) > 0 {
+			w.logger.Error().Ctx(w.Context()).
+				Str("grpc_method", w.method).
+				Interface("validation_errors", violations).
+				Msg("stream message validation failed")
+			return StatusCheck(violations)
+		}
+	}
+	return nil
+}
+
+// validateMessage checks a message against the (Rules) options of its fields, including nested messages,
+// and returns the violations keyed by the json name path of the field, e.g. "amount.currency".
+func validateMessage(msg proto.Message) map[string]string {
+	violations := make(map[string]string)
+	validateFields(msg.ProtoReflect(), "", violations)
+	return violations
+}
+
+func validateFields(m protoreflect.Message, prefix string, violations map[string]string) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + fd.JSONName()
+
+		rules, _ := proto.GetExtension(fd.Options(), pb.E_Rules).(*pb.FieldRules)
+		if rules != nil {
+			if msg := checkFieldRules(m, fd, rules); msg != "" {
+				addViolation(violations, path, msg)
+				continue
+			}
+		}
+
+		// nested messages carry their own rules, e.g. the currency of a Money field
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() && m.Has(fd) {
+			validateFields(m.Get(fd).Message(), path+".", violations)
+		}
+		// and so do the items of repeated messages, e.g. "add_pairs[1].from"
+		if fd.Kind() == protoreflect.MessageKind && fd.IsList() {
+			list := m.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				validateFields(list.Get(j).Message(), fmt.Sprintf("%s[%d].", path, j), violations)
+			}
+		}
+	}
+}
+
+// checkFieldRules returns the description of the first violated rule of the field or an empty string if the field is valid
+func checkFieldRules(m protoreflect.Message, fd protoreflect.FieldDescriptor, rules *pb.FieldRules) string {
+	value := m.Get(fd)
+
+	if rules.Required && !m.Has(fd) {
+		return "field is required"
+	}
+
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		s := value.String()
+		length := uint32(utf8.RuneCountInString(s))
+		// every configured rule is checked, the first violated one is reported
+		if rules.Uuid && s != "" {
+			if _, err := uuid.Parse(s); err != nil {
+				return "should be a valid UUID"
+			}
+		}
+		if rules.Len > 0 && length != rules.Len {
+			return fmt.Sprintf("length should be exactly %d characters", rules.Len)
+		}
+		if rules.MaxLen > 0 && length > rules.MaxLen {
+			return fmt.Sprintf("can't be longer than %d characters", rules.MaxLen)
+		}
+		if rules.Pattern != "" && !matchPattern(rules.Pattern, s) {
+			return fmt.Sprintf("should match the pattern %s", rules.Pattern)
+		}
+
+	case protoreflect.EnumKind:
+		if rules.DefinedEnum && fd.Enum().Values().ByNumber(value.Enum()) == nil {
+			return "unsupported value"
+		}
+
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		if msg := checkSign(value.Int(), rules); msg != "" {
+			return msg
+		}
+
+	case protoreflect.MessageKind:
+		if money, ok := value.Message().Interface().(*pb.Money); ok && m.Has(fd) {
+			sign := money.GetUnits()
+			if sign == 0 {
+				sign = int64(money.GetNanos())
+			}
+			if msg := checkSign(sign, rules); msg != "" {
+				return msg
+			}
+		}
+	}
+	return ""
+}
+
+// checkSign validates the Positive and NonNegative rules against the sign of a value
+func checkSign(value int64, rules *pb.FieldRules) string {
+	switch {
+	case rules.Positive && value <= 0:
+		return "should be greater than zero"
+	case rules.NonNegative && value < 0:
+		return "shouldn't be a negative number"
+	}
+	return ""
+}
+
+func matchPattern(pattern string, s string) bool {
+	re, exists := patternCache.Load(pattern)
+	if !exists {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return false
+		}
+		re, _ = patternCache.LoadOrStore(pattern, compiled)
+	}
+	return re.(*regexp.Regexp).MatchString(s)
+}
+
+// addViolation records the first violation of a field
+func addViolation(violations map[string]string, field string, msg string) {
+	if _, exists := violations[field]; !exists {
+		violations[field] = msg
+	}
+}
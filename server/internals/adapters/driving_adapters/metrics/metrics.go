@@ -0,0 +1,52 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/zerolog"
+)
+
+// MetricsAdapter serves the prometheus scrape endpoint on /metrics. It exposes the metrics of the OpenTelemetry meter provider
+// along with the go runtime and process metrics.
+type MetricsAdapter struct {
+	Srv         *http.Server
+	metricsHost string
+	metricsPort string
+	logger      *zerolog.Logger
+}
+
+func NewMetricsAdapter(metricsHost string, metricsPort string, logger *zerolog.Logger) *MetricsAdapter {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", promhttp.Handler())
+
+	return &MetricsAdapter{
+		Srv: &http.Server{
+			Addr:              net.JoinHostPort(metricsHost, metricsPort),
+			Handler:           mux,
+			ReadHeaderTimeout: 5 * time.Second,
+			WriteTimeout:      30 * time.Second,
+		},
+		metricsHost: metricsHost,
+		metricsPort: metricsPort,
+		logger:      logger,
+	}
+}
+
+func (ad *MetricsAdapter) Run() error {
+	ad.logger.Info().Msgf("starting metrics server on %s:%s", ad.metricsHost, ad.metricsPort)
+	if err := ad.Srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		ad.logger.Error().Err(err).Msg("metrics server failed to serve")
+		return err
+	}
+	return nil
+}
+
+func (ad *MetricsAdapter) Stop(ctx context.Context) error {
+	ad.logger.Info().Msg("stopping metrics server")
+	return ad.Srv.Shutdown(ctx)
+}
@@ -6,6 +6,12 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	AccountStatusActive = "active"
+	AccountStatusFrozen = "frozen" // credits are still accepted, debits are rejected
+	AccountStatusClosed = "closed" // soft deleted, no money movement is accepted
+)
+
 type BankAccounts []BankAccount
 
 type BankAccount struct {
@@ -13,7 +19,25 @@ type BankAccount struct {
 	AccountNumber  string
 	AccountName    string
 	Currency       string
-	CurrentBalance float64
+	CurrentBalance Money
+	OverdraftLimit Money
+	Status         string
+	OwnerSubject   string // subject of the customer owning the account, empty for accounts only the staff can reach
+	ClosedAt       time.Time
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
+
+// AccountFilter narrows down an account listing. Zero values mean the filter is not applied.
+type AccountFilter struct {
+	Currency     string
+	Name         string // case insensitive substring of the account name
+	Status       string // closed accounts are only listed when explicitly requested
+	OwnerSubject string // restricts the list to the accounts of a customer
+}
+
+// AccountUpdate carries the account fields selected by an update mask. Nil fields are left untouched.
+type AccountUpdate struct {
+	AccountName    *string
+	OverdraftLimit *Money
+}
@@ -20,9 +20,38 @@ type BankTransaction struct {
 	TransactionUUID      uuid.UUID
 	AccountUUID          uuid.UUID
 	TransactionTimestamp time.Time
-	Amount               float64
+	Amount               Money
 	TransactionType      string
 	Notes                string
 	CreatedAt            time.Time
 	UpdatedAt            time.Time
 }
+
+// IsDebit reports whether the transaction type takes money out of the account
+func (bt *BankTransaction) IsDebit() bool {
+	switch bt.TransactionType {
+	case TRWithDrawType, TRPaymentType, TRTransferType:
+		return true
+	default:
+		return false
+	}
+}
+
+// BalanceChange returns the signed change of the account balance caused by the transaction
+func (bt *BankTransaction) BalanceChange() Money {
+	if bt.IsDebit() {
+		return bt.Amount.Neg()
+	}
+	return bt.Amount
+}
+
+// TransactionFilter narrows down a transaction listing. Zero values mean the filter is not applied.
+type TransactionFilter struct {
+	AccountUUID     uuid.UUID
+	TransactionType string
+	From            time.Time // inclusive lower bound of the transaction timestamp
+	To              time.Time // exclusive upper bound of the transaction timestamp
+	MinAmount       *Money    // inclusive, restricts the list to the currency of the amount
+	MaxAmount       *Money    // inclusive, restricts the list to the currency of the amount
+	OwnerSubject    string    // restricts the list to the accounts of a customer
+}
@@ -1,21 +1,103 @@
 package domains
 
 import (
+	"fmt"
 	"time"
 
+	domainErrors "github.com/cybrarymin/gRPC/server/internals/domains/errors"
 	"github.com/google/uuid"
 )
 
+const (
+	TransferStatusPending   = "pending"   // transfer is recorded, no money has moved yet
+	TransferStatusDebited   = "debited"   // source account has been debited
+	TransferStatusCompleted = "completed" // destination account has been credited
+	TransferStatusFailed    = "failed"    // transfer was aborted, no money has moved
+	TransferStatusReversed  = "reversed"  // a completed transfer has been paid back to the source account
+)
+
+// transferTransitions lists the statuses a transfer may move to from each status.
+// Failed and reversed are final.
+var transferTransitions = map[string][]string{
+	TransferStatusPending:   {TransferStatusDebited, TransferStatusFailed},
+	TransferStatusDebited:   {TransferStatusCompleted, TransferStatusFailed},
+	TransferStatusCompleted: {TransferStatusReversed},
+}
+
 type BankTransfers []BankTransfer
 
 type BankTransfer struct {
 	TransferUUID      uuid.UUID
 	FromAccountUUID   uuid.UUID
 	ToAccountUUID     uuid.UUID
-	Currency          string
-	Amount            float64
+	Amount            Money  // amount credited to the destination account
+	DebitAmount       *Money // amount debited from the source account, nil until the source account is debited
 	TransferTimestamp time.Time
-	TransferSucceed   bool
+	Status            string
+	FailureReason     string
+	History           []TransferStatusChange
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
 }
+
+// TransferStatusChange is a single step of the transfer status history
+type TransferStatusChange struct {
+	FromStatus string // empty for the initial status
+	ToStatus   string
+	Reason     string
+	ChangedAt  time.Time
+}
+
+// TransferFilter narrows down a transfer listing. Zero values mean the filter is not applied.
+type TransferFilter struct {
+	AccountUUID  uuid.UUID // matches transfers sent or received by the account
+	Status       string
+	From         time.Time
+	To           time.Time
+	OwnerSubject string // matches transfers sent or received by the accounts of a customer
+}
+
+// CanTransitionTo reports whether the transfer state machine allows moving from the current status to the given one
+func (t *BankTransfer) CanTransitionTo(status string) bool {
+	for _, next := range transferTransitions[t.Status] {
+		if next == status {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionTo moves the transfer to the given status and returns the status change to record in the history.
+// The reason is stored as the failure reason when the transfer fails.
+func (t *BankTransfer) TransitionTo(status string, reason string, at time.Time) (*TransferStatusChange, error) {
+	if !t.CanTransitionTo(status) {
+		return nil, domainErrors.InvalidStatusTransitionError("bank transfer", t.TransferUUID.String(), t.Status, status)
+	}
+	change := &TransferStatusChange{
+		FromStatus: t.Status,
+		ToStatus:   status,
+		Reason:     reason,
+		ChangedAt:  at,
+	}
+	t.Status = status
+	t.UpdatedAt = at
+	if status == TransferStatusFailed {
+		t.FailureReason = reason
+	}
+	t.History = append(t.History, *change)
+	return change, nil
+}
+
+// IsFinal reports whether no further status change is possible
+func (t *BankTransfer) IsFinal() bool {
+	return len(transferTransitions[t.Status]) == 0
+}
+
+// ValidTransferStatus reports whether the status is one of the transfer state machine statuses
+func ValidTransferStatus(status string) error {
+	switch status {
+	case TransferStatusPending, TransferStatusDebited, TransferStatusCompleted, TransferStatusFailed, TransferStatusReversed:
+		return nil
+	}
+	return domainErrors.InvalidInputError(fmt.Sprintf("unknown transfer status %q", status))
+}
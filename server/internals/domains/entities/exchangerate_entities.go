@@ -0,0 +1,71 @@
+package domains
+
+import "time"
+
+// ExchangeRateQuote is an amount converted to another currency along with the rate used, its validity window and its sequence.
+// The sequence of a currency pair grows with every change of its rate. Path lists the stored rates the rate was derived from.
+type ExchangeRateQuote struct {
+	Amount    Money
+	Rate      string
+	ValidFrom time.Time
+	ValidTo   time.Time
+	Sequence  int64
+	Path      []RateLeg
+}
+
+type CurrencyPair struct {
+	From string
+	To   string
+}
+
+// ExchangeRate is the rate of a currency pair, valid from ValidFrom until ValidTo. Path lists the stored rates the rate was derived from.
+type ExchangeRate struct {
+	Pair      CurrencyPair
+	Rate      string
+	ValidFrom time.Time
+	ValidTo   time.Time
+	Sequence  int64
+	Path      []RateLeg
+}
+
+// RateLeg is a stored rate used to derive the rate of a pair. An inverted leg converts from the To to the From currency
+// of the stored pair with the inverse of the stored rate.
+// The rate of a pair comes from a single leg, direct or inverted, or from two legs crossing through the pivot currency.
+type RateLeg struct {
+	Pair     CurrencyPair
+	Rate     string
+	Inverted bool
+	Sequence int64
+}
+
+// RateSubscriptionChange adds and removes pairs of a rate subscription. A base stands for the pairs converting from that currency to every other supported currency.
+type RateSubscriptionChange struct {
+	AddPairs    []CurrencyPair
+	RemovePairs []CurrencyPair
+	AddBases    []string
+	RemoveBases []string
+}
+
+// ExchangeRateBatch is a set of rates sent together to a subscriber. Unknown lists the subscribed pairs which don't have a rate yet.
+type ExchangeRateBatch struct {
+	Rates   []ExchangeRate
+	Unknown []CurrencyPair
+}
+
+// ExchangeRateCandle aggregates the rates of a pair in effect between Start and End.
+// Open is the rate in effect at Start, Close the one in effect at End and Changes the number of rate changes in between.
+type ExchangeRateCandle struct {
+	Start   time.Time
+	End     time.Time
+	Open    string
+	High    string
+	Low     string
+	Close   string
+	Changes int
+}
+
+// ProvidedRate is a rate published by an external rate provider
+type ProvidedRate struct {
+	Pair CurrencyPair
+	Rate string
+}
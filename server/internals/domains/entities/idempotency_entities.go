@@ -0,0 +1,24 @@
+package domains
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	IdempotencyOpCreateTransaction = "CreateTransaction"
+	IdempotencyOpCreateTransfer    = "CreateTransfer"
+)
+
+// IdempotencyKey records a client supplied dedupe token together with the hash of the request it was first used with
+// and the uuid of the resource that request created.
+type IdempotencyKey struct {
+	Principal    string // principal the key belongs to, the same key sent by two principals are two different keys
+	Operation    string
+	Key          string
+	RequestHash  string
+	ResourceUUID uuid.UUID
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
@@ -0,0 +1,165 @@
+package domains
+
+import (
+	"fmt"
+	"time"
+
+	domainErrors "github.com/cybrarymin/gRPC/server/internals/domains/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	LedgerEntryOpeningBalance   = "opening_balance"
+	LedgerEntryTransaction      = "transaction"
+	LedgerEntryTransfer         = "transfer"
+	LedgerEntryTransferReversal = "transfer_reversal"
+)
+
+const (
+	SystemAccountExternal = "external" // counterpart of money entering or leaving the bank: deposits, withdrawals, payments, refunds
+	SystemAccountFX       = "fx"       // currency position taken by cross currency transfers
+	SystemAccountFees     = "fees"     // fees and rounding collected by the bank
+)
+
+// SystemAccountCode returns the ledger code of the system account of the given kind in the currency, e.g. "fx:EUR"
+func SystemAccountCode(kind string, currency string) string {
+	return kind + ":" + currency
+}
+
+// JournalEntry is a set of postings recording a single business event.
+// The postings of an entry always sum up to zero in each currency.
+type JournalEntry struct {
+	EntryUUID     uuid.UUID
+	EntryType     string
+	ReferenceUUID uuid.UUID // transaction, transfer or account the entry records
+	Description   string
+	Postings      []Posting
+	CreatedAt     time.Time
+}
+
+// Posting changes the balance of a single ledger account, either a customer bank account or a system account.
+// A positive amount increases the account balance, a negative amount decreases it.
+type Posting struct {
+	AccountUUID   uuid.UUID
+	SystemAccount string
+	Amount        Money
+}
+
+// NewJournalEntry creates an entry with the given postings
+func NewJournalEntry(entryType string, referenceUUID uuid.UUID, description string, postings ...Posting) *JournalEntry {
+	return &JournalEntry{
+		EntryUUID:     uuid.New(),
+		EntryType:     entryType,
+		ReferenceUUID: referenceUUID,
+		Description:   description,
+		Postings:      postings,
+		CreatedAt:     time.Now(),
+	}
+}
+
+// AccountPosting posts the amount on a customer bank account
+func AccountPosting(accountUUID uuid.UUID, amount Money) Posting {
+	return Posting{AccountUUID: accountUUID, Amount: amount}
+}
+
+// SystemPosting posts the amount on the system account of the given kind in the amount currency
+func SystemPosting(kind string, amount Money) Posting {
+	return Posting{SystemAccount: SystemAccountCode(kind, amount.Currency), Amount: amount}
+}
+
+// NewAccountBalanceEntry records a change of a customer account balance against money entering or leaving the bank
+func NewAccountBalanceEntry(entryType string, referenceUUID uuid.UUID, accountUUID uuid.UUID, balanceChange Money, description string) *JournalEntry {
+	return NewJournalEntry(entryType, referenceUUID, description,
+		AccountPosting(accountUUID, balanceChange),
+		SystemPosting(SystemAccountExternal, balanceChange.Neg()),
+	)
+}
+
+// NewTransferEntry records the money movement of a transfer. Cross currency transfers go through the fx system
+// accounts, and in a same currency transfer any difference between the debited and credited amount goes to fees.
+// A reversal posts the same entry with opposite signs.
+func NewTransferEntry(transfer *BankTransfer, reversal bool) (*JournalEntry, error) {
+	if transfer.DebitAmount == nil {
+		return nil, domainErrors.InvalidInputError(fmt.Sprintf("transfer %s has no debit amount", transfer.TransferUUID))
+	}
+	debit, credit := *transfer.DebitAmount, transfer.Amount
+	entryType, description := LedgerEntryTransfer, fmt.Sprintf("transfer %s", transfer.TransferUUID)
+	if reversal {
+		debit, credit = debit.Neg(), credit.Neg()
+		entryType, description = LedgerEntryTransferReversal, fmt.Sprintf("reversal of transfer %s", transfer.TransferUUID)
+	}
+
+	postings := []Posting{
+		AccountPosting(transfer.FromAccountUUID, debit.Neg()),
+		AccountPosting(transfer.ToAccountUUID, credit),
+	}
+	switch {
+	case debit.Currency != credit.Currency:
+		postings = append(postings, SystemPosting(SystemAccountFX, debit), SystemPosting(SystemAccountFX, credit.Neg()))
+	case debit.Amount != credit.Amount:
+		difference, err := debit.Add(credit.Neg())
+		if err != nil {
+			return nil, err
+		}
+		postings = append(postings, SystemPosting(SystemAccountFees, difference))
+	}
+	return NewJournalEntry(entryType, transfer.TransferUUID, description, postings...), nil
+}
+
+// Validate checks the entry has at least two postings, each on exactly one account with a non zero amount,
+// and that the postings sum up to zero in every currency
+func (e *JournalEntry) Validate() error {
+	if len(e.Postings) < 2 {
+		return domainErrors.InvalidInputError("journal entry needs at least two postings")
+	}
+	totals := make(map[string]Money)
+	for _, p := range e.Postings {
+		if (p.AccountUUID == uuid.Nil) == (p.SystemAccount == "") {
+			return domainErrors.InvalidInputError("posting should refer to either a bank account or a system account")
+		}
+		if p.Amount.IsZero() {
+			return domainErrors.InvalidInputError("posting amount can't be zero")
+		}
+		total, exists := totals[p.Amount.Currency]
+		if !exists {
+			total = NewMoney(0, p.Amount.Currency)
+		}
+		total, err := total.Add(p.Amount)
+		if err != nil {
+			return err
+		}
+		totals[p.Amount.Currency] = total
+	}
+	for currency, total := range totals {
+		if !total.IsZero() {
+			return domainErrors.UnbalancedEntryError(e.EntryUUID.String(), currency, total.Decimal())
+		}
+	}
+	return nil
+}
+
+// LedgerBalanceMismatch is a bank account whose stored balance differs from the sum of its postings
+type LedgerBalanceMismatch struct {
+	AccountUUID   uuid.UUID
+	StoredBalance Money
+	LedgerBalance Money
+}
+
+// UnbalancedJournalEntry is a journal entry whose postings don't sum up to zero in a currency
+type UnbalancedJournalEntry struct {
+	EntryUUID uuid.UUID
+	Imbalance Money
+}
+
+// LedgerReport is the outcome of a ledger verification
+type LedgerReport struct {
+	AccountsChecked   int
+	Mismatches        []LedgerBalanceMismatch
+	UnbalancedEntries []UnbalancedJournalEntry
+	CheckedAt         time.Time
+}
+
+// Balanced reports whether the verification found no discrepancy
+func (r *LedgerReport) Balanced() bool {
+	return len(r.Mismatches) == 0 && len(r.UnbalancedEntries) == 0
+}
@@ -0,0 +1,247 @@
+package domains
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	domainErrors "github.com/cybrarymin/gRPC/server/internals/domains/errors"
+)
+
+// RoundingMode defines how a converted amount is rounded to the minor unit of the target currency
+type RoundingMode int
+
+const (
+	RoundHalfEven RoundingMode = iota // round to nearest, ties to the even minor unit (banker's rounding)
+	RoundHalfUp                       // round to nearest, ties away from zero
+	RoundDown                         // truncate towards zero
+	RoundUp                           // round away from zero
+)
+
+// currencyExponents holds the number of decimal digits of the minor unit of each supported ISO 4217 currency
+var currencyExponents = map[string]int32{
+	"USD": 2,
+	"EUR": 2,
+	"CAD": 2,
+	"GBP": 2,
+	"JPY": 0,
+}
+
+// Money is an exact amount of money. Amount is expressed in the minor unit of the currency (cents for USD, yen for JPY).
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// CurrencyExponent returns the number of decimal digits used by the currency minor unit
+func CurrencyExponent(currency string) (int32, error) {
+	exp, exists := currencyExponents[currency]
+	if !exists {
+		return 0, domainErrors.InvalidCurrencyError(currency)
+	}
+	return exp, nil
+}
+
+// SupportedCurrencies returns the codes of the supported currencies in alphabetical order
+func SupportedCurrencies() []string {
+	currencies := make([]string, 0, len(currencyExponents))
+	for currency := range currencyExponents {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	return currencies
+}
+
+// NewMoney creates a money value from an amount in minor units
+func NewMoney(minorAmount int64, currency string) Money {
+	return Money{
+		Amount:   minorAmount,
+		Currency: currency,
+	}
+}
+
+// ParseMoney parses a decimal string such as "-12.30" into money.
+// Amounts with more fractional digits than the currency minor unit allows are rejected instead of being rounded.
+func ParseMoney(amount string, currency string) (Money, error) {
+	exp, err := CurrencyExponent(currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	value, ok := new(big.Rat).SetString(strings.TrimSpace(amount))
+	if !ok {
+		return Money{}, domainErrors.InvalidInputError(fmt.Sprintf("%q is not a valid decimal amount", amount))
+	}
+
+	minor := new(big.Rat).Mul(value, new(big.Rat).SetInt(pow10(exp)))
+	if !minor.IsInt() {
+		return Money{}, domainErrors.InvalidInputError(fmt.Sprintf("%s amount %s has more than %d decimal digits", currency, amount, exp))
+	}
+	if !minor.Num().IsInt64() {
+		return Money{}, domainErrors.InvalidInputError(fmt.Sprintf("amount %s is out of range", amount))
+	}
+	return NewMoney(minor.Num().Int64(), currency), nil
+}
+
+// MoneyFromUnits creates money from whole units and nano (10^-9) units, as carried by google.type.Money.
+func MoneyFromUnits(units int64, nanos int32, currency string) (Money, error) {
+	exp, err := CurrencyExponent(currency)
+	if err != nil {
+		return Money{}, err
+	}
+	if nanos <= -1e9 || nanos >= 1e9 || (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return Money{}, domainErrors.InvalidInputError("nanos must be within (-1e9, 1e9) and have the same sign as units")
+	}
+
+	nanosPerMinor := int32(pow10(9 - exp).Int64())
+	if nanos%nanosPerMinor != 0 {
+		return Money{}, domainErrors.InvalidInputError(fmt.Sprintf("%s amount has more than %d decimal digits", currency, exp))
+	}
+
+	minor := new(big.Int).Mul(big.NewInt(units), pow10(exp))
+	minor.Add(minor, big.NewInt(int64(nanos/nanosPerMinor)))
+	if !minor.IsInt64() {
+		return Money{}, domainErrors.InvalidInputError("amount is out of range")
+	}
+	return NewMoney(minor.Int64(), currency), nil
+}
+
+// Units splits the amount into whole units and nano units, as carried by google.type.Money.
+func (m Money) Units() (int64, int32) {
+	exp := currencyExponents[m.Currency]
+	scale := pow10(exp).Int64()
+	return m.Amount / scale, int32(m.Amount%scale) * int32(pow10(9-exp).Int64())
+}
+
+// Decimal returns the amount as an exact decimal string with the currency number of fractional digits, e.g. "12.30"
+func (m Money) Decimal() string {
+	exp := currencyExponents[m.Currency]
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+	}
+	digits := strconv.FormatUint(absInt64(amount), 10)
+	if exp == 0 {
+		return sign + digits
+	}
+	if len(digits) <= int(exp) {
+		digits = strings.Repeat("0", int(exp)-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-int(exp)] + "." + digits[len(digits)-int(exp):]
+}
+
+// String returns the amount followed by its currency, e.g. "12.30 USD"
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency
+}
+
+// IsNegative reports whether the amount is lower than zero
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// IsZero reports whether the amount is zero
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Neg returns the same amount with the opposite sign
+func (m Money) Neg() Money {
+	return NewMoney(-m.Amount, m.Currency)
+}
+
+// Add sums two amounts of the same currency
+func (m Money) Add(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, domainErrors.InvalidCurrencyError(o.Currency)
+	}
+	sum := m.Amount + o.Amount
+	if (o.Amount > 0 && sum < m.Amount) || (o.Amount < 0 && sum > m.Amount) {
+		return Money{}, domainErrors.InvalidInputError("amount is out of range")
+	}
+	return NewMoney(sum, m.Currency), nil
+}
+
+// Convert converts the money into another currency using an exact exchange rate.
+// The result is rounded to the minor unit of the target currency with the given rounding mode.
+func (m Money) Convert(rate *big.Rat, toCurrency string, mode RoundingMode) (Money, error) {
+	fromExp, err := CurrencyExponent(m.Currency)
+	if err != nil {
+		return Money{}, err
+	}
+	toExp, err := CurrencyExponent(toCurrency)
+	if err != nil {
+		return Money{}, err
+	}
+	if rate == nil || rate.Sign() <= 0 {
+		return Money{}, domainErrors.InvalidInputError("exchange rate should be a positive number")
+	}
+
+	// target minor amount = source minor amount * rate * 10^(toExp - fromExp)
+	value := new(big.Rat).Mul(new(big.Rat).SetInt64(m.Amount), rate)
+	if toExp >= fromExp {
+		value.Mul(value, new(big.Rat).SetInt(pow10(toExp-fromExp)))
+	} else {
+		value.Quo(value, new(big.Rat).SetInt(pow10(fromExp-toExp)))
+	}
+
+	minor := roundRat(value, mode)
+	if !minor.IsInt64() {
+		return Money{}, domainErrors.InvalidInputError("converted amount is out of range")
+	}
+	return NewMoney(minor.Int64(), toCurrency), nil
+}
+
+// ParseRate parses an exchange rate decimal string such as "1.0850000000" without losing precision
+func ParseRate(rate string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(rate))
+	if !ok || r.Sign() <= 0 {
+		return nil, domainErrors.InvalidInputError(fmt.Sprintf("%q is not a valid exchange rate", rate))
+	}
+	return r, nil
+}
+
+// roundRat rounds a rational number to an integer using the given rounding mode
+func roundRat(value *big.Rat, mode RoundingMode) *big.Int {
+	num := value.Num()
+	den := value.Denom() // always positive
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	step := big.NewInt(int64(num.Sign()))
+	// compare twice the remainder to the denominator to find out which side of the half we are on
+	half := new(big.Int).Abs(rem)
+	half.Lsh(half, 1)
+	cmp := half.Cmp(den)
+
+	switch mode {
+	case RoundDown:
+	case RoundUp:
+		quo.Add(quo, step)
+	case RoundHalfUp:
+		if cmp >= 0 {
+			quo.Add(quo, step)
+		}
+	default:
+		if cmp > 0 || (cmp == 0 && quo.Bit(0) == 1) {
+			quo.Add(quo, step)
+		}
+	}
+	return quo
+}
+
+func pow10(exp int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)
+}
+
+func absInt64(n int64) uint64 {
+	if n < 0 {
+		return uint64(-(n + 1)) + 1
+	}
+	return uint64(n)
+}
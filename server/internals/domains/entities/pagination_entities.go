@@ -0,0 +1,55 @@
+package domains
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	domainErrors "github.com/cybrarymin/gRPC/server/internals/domains/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+)
+
+// PageCursor is the keyset position of the last item of a page.
+// Lists are ordered by (Timestamp, UUID) descending so the next page starts right after the cursor.
+type PageCursor struct {
+	Timestamp time.Time `json:"ts"`
+	UUID      uuid.UUID `json:"id"`
+}
+
+// EncodePageToken returns the cursor as an opaque page token handed to the clients
+func (c *PageCursor) EncodePageToken() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodePageToken parses a page token created by EncodePageToken. An empty token returns a nil cursor which means the first page.
+func DecodePageToken(token string) (*PageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, domainErrors.InvalidInputError("invalid page token")
+	}
+	cursor := &PageCursor{}
+	if err := json.Unmarshal(raw, cursor); err != nil || cursor.UUID == uuid.Nil || cursor.Timestamp.IsZero() {
+		return nil, domainErrors.InvalidInputError("invalid page token")
+	}
+	return cursor, nil
+}
+
+// NormalizePageSize applies the default page size to unset values and caps the page size at MaxPageSize
+func NormalizePageSize(pageSize int) int {
+	switch {
+	case pageSize <= 0:
+		return DefaultPageSize
+	case pageSize > MaxPageSize:
+		return MaxPageSize
+	}
+	return pageSize
+}
@@ -0,0 +1,47 @@
+package domains
+
+import (
+	"context"
+	"slices"
+)
+
+type principalCtxKey struct{}
+
+const (
+	AuthMethodJWT    = "jwt"
+	AuthMethodAPIKey = "api_key"
+)
+
+const (
+	RoleCustomer = "customer" // acts on the accounts it owns
+	RoleTeller   = "teller"   // deposits and withdraws on any account
+	RoleAdmin    = "admin"    // manages exchange rates, closes accounts and reverses transfers
+)
+
+// Principal is the authenticated caller of a request
+type Principal struct {
+	Subject    string
+	Roles      []string
+	AuthMethod string
+}
+
+// HasRole reports whether the principal has been granted the role
+func (p *Principal) HasRole(role string) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+// IsStaff reports whether the principal works for the bank and may act on accounts it doesn't own
+func (p *Principal) IsStaff() bool {
+	return p.HasRole(RoleTeller) || p.HasRole(RoleAdmin)
+}
+
+// ContextWithPrincipal returns a copy of the context carrying the authenticated principal
+func ContextWithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalCtxKey{}, p)
+}
+
+// PrincipalFromContext returns the authenticated principal of the request, or nil when the request isn't authenticated
+func PrincipalFromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalCtxKey{}).(*Principal)
+	return p
+}
@@ -3,6 +3,7 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 // Standard error types
@@ -30,6 +31,33 @@ var (
 
 	// ErrInvalidCurrency is specific to currency operations
 	ErrInvalidCurrency = errors.New("invalid currency")
+
+	// ErrIdempotencyKeyReused represents an idempotency key replayed with a different request payload
+	ErrIdempotencyKeyReused = errors.New("idempotency key reused")
+
+	// ErrAccountFrozen represents a debit on a frozen bank account
+	ErrAccountFrozen = errors.New("account is frozen")
+
+	// ErrAccountClosed represents an operation on a closed bank account
+	ErrAccountClosed = errors.New("account is closed")
+
+	// ErrAccountBalanceNotZero represents closing a bank account which still holds money
+	ErrAccountBalanceNotZero = errors.New("account balance is not zero")
+
+	// ErrInvalidStatusTransition represents a status change which isn't allowed by the resource state machine
+	ErrInvalidStatusTransition = errors.New("invalid status transition")
+
+	// ErrUnbalancedEntry represents a journal entry whose postings don't sum up to zero
+	ErrUnbalancedEntry = errors.New("unbalanced journal entry")
+
+	// ErrNotAccountOwner represents a customer acting on an account owned by someone else
+	ErrNotAccountOwner = errors.New("account isn't owned by the caller")
+
+	// ErrRoleRequired represents an operation the caller roles don't allow
+	ErrRoleRequired = errors.New("caller role doesn't allow the operation")
+
+	// ErrStaleExchangeRate represents an exchange rate used after the end of its validity window
+	ErrStaleExchangeRate = errors.New("exchange rate is stale")
 )
 
 // NotFoundError returns a formatted not found error with the resource type and identifier
@@ -63,8 +91,8 @@ func ConcurrentModificationError(resourceType string, identifier string) error {
 }
 
 // InsufficientBalanceError returns a formatted insufficient balance error
-func InsufficientBalanceError(accountID string, currentBalance float64, requiredAmount float64) error {
-	return fmt.Errorf("%w: account %s has balance %.2f, requires %.2f",
+func InsufficientBalanceError(accountID string, currentBalance string, requiredAmount string) error {
+	return fmt.Errorf("%w: account %s has balance %s, requires %s",
 		ErrInsufficientBalance, accountID, currentBalance, requiredAmount)
 }
 
@@ -73,6 +101,51 @@ func InvalidCurrencyError(currency string) error {
 	return fmt.Errorf("%w: %s", ErrInvalidCurrency, currency)
 }
 
+// IdempotencyKeyReusedError returns a formatted idempotency key reuse error
+func IdempotencyKeyReusedError(operation string, key string) error {
+	return fmt.Errorf("%w: key %s was already used for a different %s request", ErrIdempotencyKeyReused, key, operation)
+}
+
+// AccountFrozenError returns a formatted frozen account error
+func AccountFrozenError(accountID string) error {
+	return fmt.Errorf("%w: account %s doesn't accept debits", ErrAccountFrozen, accountID)
+}
+
+// AccountClosedError returns a formatted closed account error
+func AccountClosedError(accountID string) error {
+	return fmt.Errorf("%w: account %s", ErrAccountClosed, accountID)
+}
+
+// AccountBalanceNotZeroError returns a formatted error for closing an account with a remaining balance
+func AccountBalanceNotZeroError(accountID string, currentBalance string) error {
+	return fmt.Errorf("%w: account %s has a balance of %s", ErrAccountBalanceNotZero, accountID, currentBalance)
+}
+
+// InvalidStatusTransitionError returns a formatted invalid status transition error
+func InvalidStatusTransitionError(resourceType string, identifier string, from string, to string) error {
+	return fmt.Errorf("%w: %s with identifier %s can't move from %s to %s", ErrInvalidStatusTransition, resourceType, identifier, from, to)
+}
+
+// UnbalancedEntryError returns a formatted unbalanced journal entry error
+func UnbalancedEntryError(entryID string, currency string, imbalance string) error {
+	return fmt.Errorf("%w: entry %s is off by %s %s", ErrUnbalancedEntry, entryID, imbalance, currency)
+}
+
+// NotAccountOwnerError returns a formatted error for acting on somebody else's account
+func NotAccountOwnerError(accountID string) error {
+	return fmt.Errorf("%w: account %s", ErrNotAccountOwner, accountID)
+}
+
+// RoleRequiredError returns a formatted error for an operation reserved to the given roles
+func RoleRequiredError(operation string, roles ...string) error {
+	return fmt.Errorf("%w: %s requires one of the roles %v", ErrRoleRequired, operation, roles)
+}
+
+// StaleExchangeRateError returns a formatted error for a pair whose latest rate expired before the given time
+func StaleExchangeRateError(pair string, validTo time.Time, at time.Time) error {
+	return fmt.Errorf("%w: rate of %s was valid until %s, requested at %s", ErrStaleExchangeRate, pair, validTo.Format(time.RFC3339), at.Format(time.RFC3339))
+}
+
 // IsNotFound checks if the error is a not found error
 func IsNotFound(err error) bool {
 	return errors.Is(err, ErrNotFound)
@@ -112,3 +185,48 @@ func IsInsufficientBalance(err error) bool {
 func IsInvalidCurrency(err error) bool {
 	return errors.Is(err, ErrInvalidCurrency)
 }
+
+// IsIdempotencyKeyReused checks if the error is an idempotency key reuse error
+func IsIdempotencyKeyReused(err error) bool {
+	return errors.Is(err, ErrIdempotencyKeyReused)
+}
+
+// IsAccountFrozen checks if the error is a frozen account error
+func IsAccountFrozen(err error) bool {
+	return errors.Is(err, ErrAccountFrozen)
+}
+
+// IsAccountClosed checks if the error is a closed account error
+func IsAccountClosed(err error) bool {
+	return errors.Is(err, ErrAccountClosed)
+}
+
+// IsAccountBalanceNotZero checks if the error is a non-zero balance error
+func IsAccountBalanceNotZero(err error) bool {
+	return errors.Is(err, ErrAccountBalanceNotZero)
+}
+
+// IsInvalidStatusTransition checks if the error is an invalid status transition error
+func IsInvalidStatusTransition(err error) bool {
+	return errors.Is(err, ErrInvalidStatusTransition)
+}
+
+// IsUnbalancedEntry checks if the error is an unbalanced journal entry error
+func IsUnbalancedEntry(err error) bool {
+	return errors.Is(err, ErrUnbalancedEntry)
+}
+
+// IsNotAccountOwner checks if the error is an error for acting on somebody else's account
+func IsNotAccountOwner(err error) bool {
+	return errors.Is(err, ErrNotAccountOwner)
+}
+
+// IsRoleRequired checks if the error is an error for an operation the caller roles don't allow
+func IsRoleRequired(err error) bool {
+	return errors.Is(err, ErrRoleRequired)
+}
+
+// IsStaleExchangeRate checks if the error is a stale exchange rate error
+func IsStaleExchangeRate(err error) bool {
+	return errors.Is(err, ErrStaleExchangeRate)
+}
@@ -10,12 +10,21 @@ import (
 
 type BankAccountRepositoryPort interface {
 	Create(context.Context, *domains.BankAccount) (*adapters.BankAccountModel, error)
-	DeleteByID(context.Context, uuid.UUID) error
-	Update(context.Context, uuid.UUID, *domains.BankAccount) (*adapters.BankAccountModel, error)
+	Close(context.Context, uuid.UUID) (*adapters.BankAccountModel, error)
+	SetStatus(ctx context.Context, accUUID uuid.UUID, status string) (*adapters.BankAccountModel, error)
+	Update(context.Context, uuid.UUID, *domains.AccountUpdate) (*adapters.BankAccountModel, error)
 	GetByID(context.Context, uuid.UUID) (*adapters.BankAccountModel, error)
+	List(ctx context.Context, filter *domains.AccountFilter, cursor *domains.PageCursor, limit int) (adapters.BankAccountsModel, error)
+	UpdateBalance(context.Context, uuid.UUID, domains.Money) (*adapters.BankAccountModel, error)
 }
 
 type BankAccountGrpcPort interface {
-	OpenAccount(ctx context.Context, accName string, accNum string, currency string, balance float64) (*domains.BankAccount, error)
-	GetCurrentBalance(ctx context.Context, accUUID uuid.UUID) (float64, string, error)
+	OpenAccount(ctx context.Context, accName string, accNum string, currency string, balance domains.Money, overdraftLimit domains.Money, ownerSubject string) (*domains.BankAccount, error)
+	GetCurrentBalance(ctx context.Context, accUUID uuid.UUID) (domains.Money, error)
+	GetAccount(ctx context.Context, accUUID uuid.UUID) (*domains.BankAccount, error)
+	ListAccounts(ctx context.Context, filter *domains.AccountFilter, pageSize int, pageToken string) (domains.BankAccounts, string, error)
+	UpdateAccount(ctx context.Context, accUUID uuid.UUID, update *domains.AccountUpdate) (*domains.BankAccount, error)
+	FreezeAccount(ctx context.Context, accUUID uuid.UUID) (*domains.BankAccount, error)
+	UnfreezeAccount(ctx context.Context, accUUID uuid.UUID) (*domains.BankAccount, error)
+	CloseAccount(ctx context.Context, accUUID uuid.UUID) (*domains.BankAccount, error)
 }
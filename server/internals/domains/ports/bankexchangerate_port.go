@@ -2,14 +2,24 @@ package domains
 
 import (
 	"context"
+	"time"
 
 	adapters "github.com/cybrarymin/gRPC/server/internals/adapters/driven_adapters/database"
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
 )
 
 type BankExchangeRateRepositoryPort interface {
 	GetByCurrencies(pCtx context.Context, FromCurrency string, ToCurrency string) (*adapters.ExchangeRateModel, error)
+	GetLatest(pCtx context.Context, FromCurrency string, ToCurrency string) (*adapters.ExchangeRateModel, error)
+	GetAsOf(pCtx context.Context, FromCurrency string, ToCurrency string, at time.Time) (*adapters.ExchangeRateModel, error)
+	SaveRate(pCtx context.Context, FromCurrency string, ToCurrency string, rate string, validFrom time.Time, validTo time.Time) (*adapters.ExchangeRateModel, error)
+	GetHistory(pCtx context.Context, FromCurrency string, ToCurrency string, start time.Time, end time.Time, interval time.Duration) (*adapters.ExchangeRateHistoryModel, []adapters.ExchangeRateBucketModel, error)
 }
 
 type BankExchangeRateGrpcPort interface {
-	CalculateRate(ctx context.Context, fromCurrency string, toCurrency string, amount float64) (float64, error)
+	CalculateRate(ctx context.Context, amount domains.Money, toCurrency string, roundingMode domains.RoundingMode) (domains.Money, error)
+	WatchRate(ctx context.Context, amount domains.Money, toCurrency string, roundingMode domains.RoundingMode, send func(domains.ExchangeRateQuote) error) error
+	SubscribeRates(ctx context.Context, changes <-chan domains.RateSubscriptionChange, send func(domains.ExchangeRateBatch) error) error
+	GetRateAsOf(ctx context.Context, pair domains.CurrencyPair, at time.Time) (domains.ExchangeRate, error)
+	GetRateHistory(ctx context.Context, pair domains.CurrencyPair, start time.Time, end time.Time, interval time.Duration) ([]domains.ExchangeRateCandle, error)
 }
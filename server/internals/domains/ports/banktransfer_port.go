@@ -10,10 +10,15 @@ import (
 
 type BankTransferRepositoryPort interface {
 	CreateTransfer(pCtx context.Context, ntransfer *domains.BankTransfer) (*adapters.BankTransferModel, error)
-	UpdateTransfer(pCtx context.Context, transferUUID uuid.UUID, ntransfer *domains.BankTransfer) (*adapters.BankTransferModel, error)
+	UpdateTransferStatus(pCtx context.Context, ntransfer *domains.BankTransfer, change *domains.TransferStatusChange) (*adapters.BankTransferModel, error)
 	GetTransferByID(pCtx context.Context, transferUUID uuid.UUID) (*adapters.BankTransferModel, error)
+	GetTransferHistory(pCtx context.Context, transferUUID uuid.UUID) (adapters.TransferStatusHistoryModels, error)
+	ListTransfers(pCtx context.Context, filter *domains.TransferFilter, cursor *domains.PageCursor, limit int) (adapters.BankTransfersModel, error)
 }
 
 type BankTransferGrpcPort interface {
-	TransferMoney(ctx context.Context, srcAccount uuid.UUID, dstAccount uuid.UUID, currency string, amount float64) (*domains.BankTransfer, error)
+	TransferMoney(ctx context.Context, srcAccount uuid.UUID, dstAccount uuid.UUID, amount domains.Money, idempotencyKey string) (*domains.BankTransfer, error)
+	GetTransfer(ctx context.Context, transferUUID uuid.UUID) (*domains.BankTransfer, error)
+	ListTransfers(ctx context.Context, filter *domains.TransferFilter, pageSize int, pageToken string) (domains.BankTransfers, string, error)
+	ReverseTransfer(ctx context.Context, transferUUID uuid.UUID, reason string) (*domains.BankTransfer, error)
 }
@@ -12,8 +12,11 @@ type TransactionRepositoryPort interface {
 	CreateTransaction(context.Context, *domains.BankTransaction) (*adapters.BankTransactionModel, error)
 	GetTransactionByID(context.Context, uuid.UUID) (*adapters.BankTransactionModel, error)
 	GetTransactionsByAccount(context.Context, uuid.UUID) (adapters.BankTransactionsModel, error)
+	ListTransactions(ctx context.Context, filter *domains.TransactionFilter, cursor *domains.PageCursor, limit int) (adapters.BankTransactionsModel, error)
 }
 
 type TransactionGrpcPort interface {
-	NewTransaction(ctx context.Context, accUUID uuid.UUID, amount float64, TRType string, note string) (*domains.BankTransaction, error)
+	NewTransaction(ctx context.Context, accUUID uuid.UUID, amount domains.Money, TRType string, note string, idempotencyKey string) (*domains.BankTransaction, error)
+	GetTransaction(ctx context.Context, transactionUUID uuid.UUID) (*domains.BankTransaction, error)
+	ListTransactions(ctx context.Context, filter *domains.TransactionFilter, pageSize int, pageToken string) (domains.BankTransactions, string, error)
 }
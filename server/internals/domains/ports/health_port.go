@@ -0,0 +1,13 @@
+package domains
+
+import (
+	"context"
+)
+
+type HealthRepositoryPort interface {
+	Ping(ctx context.Context) error
+}
+
+type HealthGrpcPort interface {
+	CheckReadiness(ctx context.Context) error
+}
@@ -0,0 +1,14 @@
+package domains
+
+import (
+	"context"
+
+	adapters "github.com/cybrarymin/gRPC/server/internals/adapters/driven_adapters/database"
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	"github.com/google/uuid"
+)
+
+type IdempotencyKeyRepositoryPort interface {
+	ReserveIdempotencyKey(context.Context, *domains.IdempotencyKey) (*adapters.IdempotencyKeyModel, bool, error)
+	SetIdempotencyKeyResource(ctx context.Context, principal string, operation string, key string, resourceUUID uuid.UUID) error
+}
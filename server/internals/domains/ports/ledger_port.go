@@ -0,0 +1,19 @@
+package domains
+
+import (
+	"context"
+
+	adapters "github.com/cybrarymin/gRPC/server/internals/adapters/driven_adapters/database"
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+)
+
+type LedgerRepositoryPort interface {
+	CreateJournalEntry(pCtx context.Context, entry *domains.JournalEntry) error
+	CountAccounts(pCtx context.Context) (int, error)
+	FindBalanceMismatches(pCtx context.Context) ([]adapters.LedgerBalanceMismatchModel, error)
+	FindUnbalancedEntries(pCtx context.Context) ([]adapters.UnbalancedEntryModel, error)
+}
+
+type LedgerGrpcPort interface {
+	VerifyLedger(ctx context.Context) (*domains.LedgerReport, error)
+}
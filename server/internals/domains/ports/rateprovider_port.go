@@ -0,0 +1,19 @@
+package domains
+
+import (
+	"context"
+
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+)
+
+// RateProviderPort fetches the exchange rates published by an external source
+type RateProviderPort interface {
+	Name() string
+	FetchRates(ctx context.Context) ([]domains.ProvidedRate, error)
+}
+
+// RateProviderWatcherPort is implemented by the providers noticing when their rates change.
+// The channel is signaled on every change and closed once the context is done.
+type RateProviderWatcherPort interface {
+	Watch(ctx context.Context) (<-chan struct{}, error)
+}
@@ -0,0 +1,11 @@
+package domains
+
+import (
+	"context"
+)
+
+// UnitOfWorkPort groups several repository calls into one atomic database transaction.
+// Repositories invoked with txCtx take part in the transaction, everything is rolled back if fn returns an error.
+type UnitOfWorkPort interface {
+	WithinTransaction(ctx context.Context, fn func(txCtx context.Context) error) error
+}
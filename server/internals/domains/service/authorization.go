@@ -0,0 +1,54 @@
+package domains
+
+import (
+	"context"
+
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	domainErrors "github.com/cybrarymin/gRPC/server/internals/domains/errors"
+	ports "github.com/cybrarymin/gRPC/server/internals/domains/ports"
+	"github.com/google/uuid"
+)
+
+// The role of the caller for each RPC is enforced by the grpc adapter, the services only check which accounts the caller may reach.
+// Requests without a principal come from a server running with authentication disabled and reach every account.
+
+// ownerScope returns the subject whose accounts the caller is restricted to, or an empty string when the caller may reach every account
+func ownerScope(ctx context.Context) string {
+	principal := domains.PrincipalFromContext(ctx)
+	if principal == nil || principal.IsStaff() {
+		return ""
+	}
+	return principal.Subject
+}
+
+// authorizeAccount checks the caller may act on the account
+func authorizeAccount(ctx context.Context, account *domains.BankAccount) error {
+	scope := ownerScope(ctx)
+	if scope != "" && account.OwnerSubject != scope {
+		return domainErrors.NotAccountOwnerError(account.AccountUUID.String())
+	}
+	return nil
+}
+
+// authorizeAccountUUID loads the account only when the caller is restricted to its own accounts and checks the caller owns it
+func authorizeAccountUUID(ctx context.Context, accountPort ports.BankAccountRepositoryPort, accUUID uuid.UUID) error {
+	if ownerScope(ctx) == "" {
+		return nil
+	}
+	accountModel, err := accountPort.GetByID(ctx, accUUID)
+	if err != nil {
+		return err
+	}
+	if accountModel.OwnerSubject != ownerScope(ctx) {
+		return domainErrors.NotAccountOwnerError(accUUID.String())
+	}
+	return nil
+}
+
+// requireStaff rejects callers who aren't tellers or admins
+func requireStaff(ctx context.Context, operation string) error {
+	if ownerScope(ctx) != "" {
+		return domainErrors.RoleRequiredError(operation, domains.RoleTeller, domains.RoleAdmin)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	domainErrors "github.com/cybrarymin/gRPC/server/internals/domains/errors"
 	ports "github.com/cybrarymin/gRPC/server/internals/domains/ports"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
@@ -13,40 +14,77 @@ import (
 )
 
 type BankAccountService struct {
-	port      ports.BankAccountRepositoryPort
-	logger    *zerolog.Logger
-	validator *Validator
+	port       ports.BankAccountRepositoryPort
+	uowPort    ports.UnitOfWorkPort
+	ledgerPort ports.LedgerRepositoryPort
+	logger     *zerolog.Logger
 }
 
-func NewBankAccountService(repoPort ports.BankAccountRepositoryPort, logger *zerolog.Logger, validator *Validator) *BankAccountService {
+func NewBankAccountService(repoPort ports.BankAccountRepositoryPort, uowPort ports.UnitOfWorkPort, ledgerPort ports.LedgerRepositoryPort, logger *zerolog.Logger) *BankAccountService {
 	return &BankAccountService{
-		port:      repoPort,
-		logger:    logger,
-		validator: validator,
+		port:       repoPort,
+		uowPort:    uowPort,
+		ledgerPort: ledgerPort,
+		logger:     logger,
 	}
 }
 
-func (s *BankAccountService) OpenAccount(ctx context.Context, accName string, accNumber string, currency string, balance float64) (*domains.BankAccount, error) {
+// OpenAccount opens an account owned by ownerSubject. Customers always open accounts for themselves with a zero balance
+// and no overdraft, only the staff funds accounts and opens them on behalf of a customer.
+func (s *BankAccountService) OpenAccount(ctx context.Context, accName string, accNumber string, currency string, balance domains.Money, overdraftLimit domains.Money, ownerSubject string) (*domains.BankAccount, error) {
 	sCtx, nSpan := otel.Tracer("OpenAccount").Start(ctx, "OpenAccount.service.span")
 	defer nSpan.End()
 
+	if scope := ownerScope(sCtx); scope != "" {
+		var err error
+		switch {
+		case ownerSubject != "" && ownerSubject != scope:
+			err = domainErrors.RoleRequiredError("opening an account for another customer", domains.RoleTeller, domains.RoleAdmin)
+		case !balance.IsZero() || !overdraftLimit.IsZero():
+			err = domainErrors.RoleRequiredError("opening an account with a balance or an overdraft limit", domains.RoleTeller, domains.RoleAdmin)
+		}
+		if err != nil {
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "caller isn't allowed to open the account")
+			return nil, err
+		}
+		ownerSubject = scope
+	}
+
 	nAccount := &domains.BankAccount{
 		AccountNumber:  accNumber,
 		AccountName:    accName,
 		Currency:       currency,
 		CurrentBalance: balance,
+		OverdraftLimit: overdraftLimit,
+		Status:         domains.AccountStatusActive,
+		OwnerSubject:   ownerSubject,
 		CreatedAt:      time.Now(),
 		UpdatedAt:      time.Now(),
 	}
 
-	s.logger.Info().
+	s.logger.Info().Ctx(sCtx).
 		Str("account_name", nAccount.AccountName).
 		Str("account_number", nAccount.AccountNumber).
 		Msg("staring bank account creation process....")
 
-	createdAccount, err := s.port.Create(sCtx, nAccount)
+	// the account and the journal entry of its opening balance are committed together
+	err := s.uowPort.WithinTransaction(sCtx, func(txCtx context.Context) error {
+		createdAccount, err := s.port.Create(txCtx, nAccount)
+		if err != nil {
+			return err
+		}
+		nAccount.AccountUUID = createdAccount.AccountUUID
+
+		if balance.IsZero() {
+			return nil
+		}
+		entry := domains.NewAccountBalanceEntry(domains.LedgerEntryOpeningBalance, nAccount.AccountUUID, nAccount.AccountUUID, balance,
+			"opening balance of account "+nAccount.AccountNumber)
+		return postJournalEntry(txCtx, s.ledgerPort, entry)
+	})
 	if err != nil {
-		s.logger.Error().Err(err).
+		s.logger.Error().Ctx(sCtx).Err(err).
 			Str("account_id", nAccount.AccountUUID.String()).
 			Str("account_number", nAccount.AccountNumber).
 			Str("account_name", nAccount.AccountName).
@@ -56,34 +94,222 @@ func (s *BankAccountService) OpenAccount(ctx context.Context, accName string, ac
 		return nil, err
 	}
 
-	s.logger.Info().
+	s.logger.Info().Ctx(sCtx).
 		Msg("ending bank account creation process....")
-	nAccount.AccountUUID = createdAccount.AccountUUID
 	return nAccount, nil
 }
 
-func (s *BankAccountService) GetCurrentBalance(ctx context.Context, accUUID uuid.UUID) (float64, string, error) {
+func (s *BankAccountService) GetCurrentBalance(ctx context.Context, accUUID uuid.UUID) (domains.Money, error) {
 	sCtx, nSpan := otel.Tracer("GetCurrentBalance").Start(ctx, "GetCurrentBalance.service.span")
 	defer nSpan.End()
 
-	s.logger.Info().
+	s.logger.Info().Ctx(sCtx).
 		Str("account_uuid", accUUID.String()).
 		Msg("fetching account uuid information to get its current balance...")
 
 	bankAccountModel, err := s.port.GetByID(sCtx, accUUID)
 	if err != nil {
-		s.logger.Error().Err(err).
+		s.logger.Error().Ctx(sCtx).Err(err).
 			Str("account_uuid", accUUID.String()).
 			Msg("couldn't get requested account information")
 		nSpan.RecordError(err)
 		nSpan.SetStatus(codes.Error, "failed to retrieve user")
-		return 0, "", err
+		return domains.Money{}, err
+	}
+
+	bankAccount, err := bankAccountModel.ToBankAccount()
+	if err != nil {
+		s.logger.Error().Ctx(sCtx).Err(err).
+			Str("account_uuid", accUUID.String()).
+			Msg("couldn't convert stored account balance")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to convert account balance")
+		return domains.Money{}, err
+	}
+
+	if err := authorizeAccount(sCtx, bankAccount); err != nil {
+		s.logger.Warn().Ctx(sCtx).Err(err).
+			Str("account_uuid", accUUID.String()).
+			Msg("caller isn't allowed to read the account balance")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "caller isn't allowed to read the account")
+		return domains.Money{}, err
 	}
 
-	s.logger.Info().
+	s.logger.Info().Ctx(sCtx).
 		Str("account_uuid", accUUID.String()).
-		Float64("balance", bankAccountModel.CurrentBalance).
+		Str("balance", bankAccount.CurrentBalance.String()).
 		Msg("finished getting account current balance...")
 
-	return bankAccountModel.CurrentBalance, bankAccountModel.Currency, nil
+	return bankAccount.CurrentBalance, nil
+}
+
+// GetAccount returns the account by its uuid, closed accounts included
+func (s *BankAccountService) GetAccount(ctx context.Context, accUUID uuid.UUID) (*domains.BankAccount, error) {
+	sCtx, nSpan := otel.Tracer("GetAccount").Start(ctx, "GetAccount.service.span")
+	defer nSpan.End()
+
+	bankAccountModel, err := s.port.GetByID(sCtx, accUUID)
+	if err != nil {
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to get account")
+		return nil, err
+	}
+
+	bankAccount, err := bankAccountModel.ToBankAccount()
+	if err != nil {
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to convert account")
+		return nil, err
+	}
+	if err := authorizeAccount(sCtx, bankAccount); err != nil {
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "caller isn't allowed to read the account")
+		return nil, err
+	}
+	return bankAccount, nil
+}
+
+// ListAccounts returns a page of accounts matching the filter, newest first, and the token of the next page.
+// An empty next page token means there are no more accounts. Customers only get their own accounts.
+func (s *BankAccountService) ListAccounts(ctx context.Context, filter *domains.AccountFilter, pageSize int, pageToken string) (domains.BankAccounts, string, error) {
+	sCtx, nSpan := otel.Tracer("ListAccounts").Start(ctx, "ListAccounts.service.span")
+	defer nSpan.End()
+
+	cursor, err := domains.DecodePageToken(pageToken)
+	if err != nil {
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "invalid page token")
+		return nil, "", err
+	}
+
+	if scope := ownerScope(sCtx); scope != "" {
+		filter.OwnerSubject = scope
+	}
+
+	// fetch one extra row to find out whether there is a next page
+	pageSize = domains.NormalizePageSize(pageSize)
+	accountModels, err := s.port.List(sCtx, filter, cursor, pageSize+1)
+	if err != nil {
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to list accounts")
+		return nil, "", err
+	}
+
+	nextPageToken := ""
+	if len(accountModels) > pageSize {
+		accountModels = accountModels[:pageSize]
+		last := accountModels[pageSize-1]
+		nextPageToken = (&domains.PageCursor{
+			Timestamp: last.CreatedAt,
+			UUID:      last.AccountUUID,
+		}).EncodePageToken()
+	}
+
+	accounts := make(domains.BankAccounts, 0, len(accountModels))
+	for i := range accountModels {
+		account, err := accountModels[i].ToBankAccount()
+		if err != nil {
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "failed to convert account")
+			return nil, "", err
+		}
+		accounts = append(accounts, *account)
+	}
+	return accounts, nextPageToken, nil
+}
+
+// UpdateAccount applies the fields selected by the update mask to an open account.
+// Customers may rename their own accounts, the overdraft limit is only granted by the staff.
+func (s *BankAccountService) UpdateAccount(ctx context.Context, accUUID uuid.UUID, update *domains.AccountUpdate) (*domains.BankAccount, error) {
+	sCtx, nSpan := otel.Tracer("UpdateAccount").Start(ctx, "UpdateAccount.service.span")
+	defer nSpan.End()
+
+	if update.AccountName == nil && update.OverdraftLimit == nil {
+		err := domainErrors.InvalidInputError("update mask doesn't select any field")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "empty account update")
+		return nil, err
+	}
+
+	if update.OverdraftLimit != nil {
+		if err := requireStaff(sCtx, "changing the overdraft limit"); err != nil {
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "caller isn't allowed to change the overdraft limit")
+			return nil, err
+		}
+	}
+	if err := authorizeAccountUUID(sCtx, s.port, accUUID); err != nil {
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "caller isn't allowed to update the account")
+		return nil, err
+	}
+
+	bankAccountModel, err := s.port.Update(sCtx, accUUID, update)
+	if err != nil {
+		s.logger.Error().Ctx(sCtx).Err(err).
+			Str("account_uuid", accUUID.String()).
+			Msg("failed to update bank account")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to update account")
+		return nil, err
+	}
+
+	s.logger.Info().Ctx(sCtx).
+		Str("account_uuid", accUUID.String()).
+		Msg("bank account updated")
+	return bankAccountModel.ToBankAccount()
+}
+
+// FreezeAccount blocks the debits of the account. Credits are still accepted.
+func (s *BankAccountService) FreezeAccount(ctx context.Context, accUUID uuid.UUID) (*domains.BankAccount, error) {
+	return s.changeStatus(ctx, accUUID, domains.AccountStatusFrozen)
+}
+
+// UnfreezeAccount makes a frozen account active again
+func (s *BankAccountService) UnfreezeAccount(ctx context.Context, accUUID uuid.UUID) (*domains.BankAccount, error) {
+	return s.changeStatus(ctx, accUUID, domains.AccountStatusActive)
+}
+
+// CloseAccount soft deletes an account with a zero balance. Closed accounts keep their history but accept no money movement.
+func (s *BankAccountService) CloseAccount(ctx context.Context, accUUID uuid.UUID) (*domains.BankAccount, error) {
+	sCtx, nSpan := otel.Tracer("CloseAccount").Start(ctx, "CloseAccount.service.span")
+	defer nSpan.End()
+
+	bankAccountModel, err := s.port.Close(sCtx, accUUID)
+	if err != nil {
+		s.logger.Error().Ctx(sCtx).Err(err).
+			Str("account_uuid", accUUID.String()).
+			Msg("failed to close bank account")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to close account")
+		return nil, err
+	}
+
+	s.logger.Info().Ctx(sCtx).
+		Str("account_uuid", accUUID.String()).
+		Msg("bank account closed")
+	return bankAccountModel.ToBankAccount()
+}
+
+func (s *BankAccountService) changeStatus(ctx context.Context, accUUID uuid.UUID, status string) (*domains.BankAccount, error) {
+	sCtx, nSpan := otel.Tracer("ChangeAccountStatus").Start(ctx, "ChangeAccountStatus.service.span")
+	defer nSpan.End()
+
+	bankAccountModel, err := s.port.SetStatus(sCtx, accUUID, status)
+	if err != nil {
+		s.logger.Error().Ctx(sCtx).Err(err).
+			Str("account_uuid", accUUID.String()).
+			Str("status", status).
+			Msg("failed to change bank account status")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to change account status")
+		return nil, err
+	}
+
+	s.logger.Info().Ctx(sCtx).
+		Str("account_uuid", accUUID.String()).
+		Str("status", status).
+		Msg("bank account status changed")
+	return bankAccountModel.ToBankAccount()
 }
@@ -2,7 +2,14 @@ package domains
 
 import (
 	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
 
+	adapters "github.com/cybrarymin/gRPC/server/internals/adapters/driven_adapters/database"
+	entities "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	domainErrors "github.com/cybrarymin/gRPC/server/internals/domains/errors"
 	domains "github.com/cybrarymin/gRPC/server/internals/domains/ports"
 	"github.com/rs/zerolog"
 	"go.opentelemetry.io/otel"
@@ -10,26 +17,437 @@ import (
 )
 
 type BankExchangeRateService struct {
-	port   domains.BankExchangeRateRepositoryPort
-	logger *zerolog.Logger
+	port        domains.BankExchangeRateRepositoryPort
+	rates       *RateResolver
+	broadcaster *RateBroadcaster
+	logger      *zerolog.Logger
 }
 
-func NewBankExchangeRateService(port domains.BankExchangeRateRepositoryPort, logger *zerolog.Logger) *BankExchangeRateService {
+func NewBankExchangeRateService(port domains.BankExchangeRateRepositoryPort, rates *RateResolver, broadcaster *RateBroadcaster, logger *zerolog.Logger) *BankExchangeRateService {
 	return &BankExchangeRateService{
-		port:   port,
-		logger: logger,
+		port:        port,
+		rates:       rates,
+		broadcaster: broadcaster,
+		logger:      logger,
 	}
 }
 
-func (s *BankExchangeRateService) CalculateRate(ctx context.Context, fromCurrency string, toCurrency string, amount float64) (float64, error) {
+// CalculateRate converts the amount to the target currency using the exact exchange rate and the requested rounding mode
+func (s *BankExchangeRateService) CalculateRate(ctx context.Context, amount entities.Money, toCurrency string, roundingMode entities.RoundingMode) (entities.Money, error) {
 	sCtx, nSpan := otel.Tracer("CalculateRate").Start(ctx, "CalculateRate.service.span")
 	defer nSpan.End()
 
-	exRate, err := s.port.GetByCurrencies(sCtx, fromCurrency, toCurrency)
+	exRate, err := s.rates.current(sCtx, entities.CurrencyPair{From: amount.Currency, To: toCurrency})
 	if err != nil {
 		nSpan.RecordError(err)
 		nSpan.SetStatus(codes.Error, "failed to get exchange rate")
-		return 0, err
+		return entities.Money{}, err
 	}
-	return exRate.Rate * amount, nil
+
+	quote, err := convertWithRate(exRate, amount, roundingMode)
+	if err != nil {
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to convert amount")
+		return entities.Money{}, err
+	}
+	return quote.Amount, nil
+}
+
+// WatchRate sends the amount converted with the current rate, then converts and sends it again every time the rate of the pair changes.
+// It returns when the context is done or send fails.
+func (s *BankExchangeRateService) WatchRate(ctx context.Context, amount entities.Money, toCurrency string, roundingMode entities.RoundingMode, send func(entities.ExchangeRateQuote) error) error {
+	sCtx, nSpan := otel.Tracer("WatchRate").Start(ctx, "WatchRate.service.span")
+	defer nSpan.End()
+
+	// subscribe to every stored pair the rate may be derived from before reading them, so a change written in between isn't missed
+	// and a direct rate written later replaces the cross rate
+	pair := entities.CurrencyPair{From: amount.Currency, To: toCurrency}
+	candidates := s.rates.candidates(pair)
+	sub := s.broadcaster.Subscribe(candidates...)
+	defer sub.Close()
+
+	// the quotes carry the validity window of the rate, so the latest rate is streamed even once it expired
+	stored := make(map[entities.CurrencyPair]*adapters.ExchangeRateModel)
+	if err := s.rates.loadLatest(sCtx, candidates, stored); err != nil {
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to get exchange rate")
+		return err
+	}
+
+	var last *resolvedRate
+	for {
+		exRate, err := s.rates.cached(sCtx, pair, stored)
+		if err != nil {
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "failed to get exchange rate")
+			return err
+		}
+		if exRate.newerThan(last) {
+			quote, err := convertWithRate(exRate, amount, roundingMode)
+			if err != nil {
+				nSpan.RecordError(err)
+				nSpan.SetStatus(codes.Error, "failed to convert amount")
+				return err
+			}
+			if err := send(quote); err != nil {
+				return err
+			}
+			last = exRate
+		}
+
+		changes, err := sub.Next(sCtx)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			cacheRate(stored, change)
+		}
+	}
+}
+
+// rateBatchWindow is the time the changes are collected before being sent as a single batch to a SubscribeRates subscriber
+const rateBatchWindow = 250 * time.Millisecond
+
+// subscribedRates is the state of a SubscribeRates stream: the subscribed pairs and bases, the stored rates they are derived from
+// and the last rate sent for each pair
+type subscribedRates struct {
+	sub    *RateSubscription
+	pairs  map[entities.CurrencyPair]bool
+	bases  map[string]bool
+	stored map[entities.CurrencyPair]*adapters.ExchangeRateModel
+	sent   map[entities.CurrencyPair]*resolvedRate
+}
+
+// views returns the subscribed pairs, including the pairs converting from the subscribed bases, ordered by pair
+func (r *subscribedRates) views() []entities.CurrencyPair {
+	views := make(map[entities.CurrencyPair]bool)
+	for pair := range r.pairs {
+		views[pair] = true
+	}
+	for base := range r.bases {
+		for _, currency := range entities.SupportedCurrencies() {
+			if currency != base {
+				views[entities.CurrencyPair{From: base, To: currency}] = true
+			}
+		}
+	}
+	return sortedPairs(views)
+}
+
+func sortedPairs(pairs map[entities.CurrencyPair]bool) []entities.CurrencyPair {
+	sorted := make([]entities.CurrencyPair, 0, len(pairs))
+	for pair := range pairs {
+		sorted = append(sorted, pair)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].From != sorted[j].From {
+			return sorted[i].From < sorted[j].From
+		}
+		return sorted[i].To < sorted[j].To
+	})
+	return sorted
+}
+
+// SubscribeRates sends the rates of the pairs subscribed through the changes channel. The current rates of the added pairs are sent right away,
+// then the rate changes are sent in batches. It returns when the context is done or send fails.
+func (s *BankExchangeRateService) SubscribeRates(ctx context.Context, changes <-chan entities.RateSubscriptionChange, send func(entities.ExchangeRateBatch) error) error {
+	sCtx, nSpan := otel.Tracer("SubscribeRates").Start(ctx, "SubscribeRates.service.span")
+	defer nSpan.End()
+
+	subscribed := &subscribedRates{
+		sub:    s.broadcaster.Subscribe(),
+		pairs:  make(map[entities.CurrencyPair]bool),
+		bases:  make(map[string]bool),
+		stored: make(map[entities.CurrencyPair]*adapters.ExchangeRateModel),
+		sent:   make(map[entities.CurrencyPair]*resolvedRate),
+	}
+	defer subscribed.sub.Close()
+
+	var flush <-chan time.Time
+	for {
+		select {
+		case <-sCtx.Done():
+			return sCtx.Err()
+
+		case change, ok := <-changes:
+			if !ok {
+				// the client won't change the subscription anymore, keep streaming the rates
+				changes = nil
+				continue
+			}
+			batch, err := s.applySubscriptionChange(sCtx, subscribed, change)
+			if err != nil {
+				nSpan.RecordError(err)
+				nSpan.SetStatus(codes.Error, "failed to get the subscribed exchange rates")
+				return err
+			}
+			if len(batch.Rates) == 0 && len(batch.Unknown) == 0 {
+				continue
+			}
+			if err := send(batch); err != nil {
+				return err
+			}
+
+		case <-subscribed.sub.Ready():
+			if flush == nil {
+				flush = time.After(rateBatchWindow)
+			}
+
+		case <-flush:
+			flush = nil
+			changed := make(map[entities.CurrencyPair]bool)
+			for _, exRate := range subscribed.sub.Drain() {
+				cacheRate(subscribed.stored, exRate)
+				changed[entities.CurrencyPair{From: exRate.FromCurrency, To: exRate.ToCurrency}] = true
+			}
+
+			// the rates derived from a changed stored rate are sent again, a change published again by the listener resync isn't
+			batch := entities.ExchangeRateBatch{}
+			for _, pair := range subscribed.views() {
+				affected := false
+				for _, candidate := range s.rates.candidates(pair) {
+					affected = affected || changed[candidate]
+				}
+				if !affected {
+					continue
+				}
+				exRate, err := s.rates.cached(sCtx, pair, subscribed.stored)
+				if err != nil || !exRate.newerThan(subscribed.sent[pair]) {
+					continue
+				}
+				subscribed.sent[pair] = exRate
+				batch.Rates = append(batch.Rates, exRate.toExchangeRate())
+			}
+			if len(batch.Rates) == 0 {
+				continue
+			}
+			if err := send(batch); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// applySubscriptionChange updates the subscription and returns the current rates of the added pairs
+func (s *BankExchangeRateService) applySubscriptionChange(ctx context.Context, subscribed *subscribedRates, change entities.RateSubscriptionChange) (entities.ExchangeRateBatch, error) {
+	for _, pair := range change.RemovePairs {
+		delete(subscribed.pairs, pair)
+	}
+	for _, base := range change.RemoveBases {
+		delete(subscribed.bases, base)
+	}
+	for _, pair := range change.AddPairs {
+		subscribed.pairs[pair] = true
+	}
+	for _, base := range change.AddBases {
+		subscribed.bases[base] = true
+	}
+
+	views := subscribed.views()
+	wanted := make(map[entities.CurrencyPair]bool)
+	for _, pair := range views {
+		for _, candidate := range s.rates.candidates(pair) {
+			wanted[candidate] = true
+		}
+	}
+	kept := make(map[entities.CurrencyPair]bool)
+	for _, pair := range views {
+		kept[pair] = true
+	}
+	for pair := range subscribed.sent {
+		if !kept[pair] {
+			delete(subscribed.sent, pair)
+		}
+	}
+	unwanted := []entities.CurrencyPair{}
+	for pair := range subscribed.stored {
+		if !wanted[pair] {
+			unwanted = append(unwanted, pair)
+			delete(subscribed.stored, pair)
+		}
+	}
+	subscribed.sub.RemovePairs(unwanted...)
+
+	// subscribe before reading the current rates so a change written in between isn't missed
+	storedPairs := sortedPairs(wanted)
+	subscribed.sub.AddPairs(storedPairs...)
+	if err := s.rates.loadLatest(ctx, storedPairs, subscribed.stored); err != nil {
+		return entities.ExchangeRateBatch{}, err
+	}
+
+	batch := entities.ExchangeRateBatch{}
+	added := make(map[entities.CurrencyPair]bool)
+	current := func(pair entities.CurrencyPair) bool {
+		if added[pair] {
+			return true
+		}
+		exRate, err := s.rates.cached(ctx, pair, subscribed.stored)
+		if err != nil {
+			return false
+		}
+		added[pair] = true
+		subscribed.sent[pair] = exRate
+		batch.Rates = append(batch.Rates, exRate.toExchangeRate())
+		return true
+	}
+	for _, pair := range change.AddPairs {
+		if !current(pair) {
+			batch.Unknown = append(batch.Unknown, pair)
+		}
+	}
+	// the pairs of a base without any rate are left out
+	for _, base := range change.AddBases {
+		for _, currency := range entities.SupportedCurrencies() {
+			if currency != base {
+				current(entities.CurrencyPair{From: base, To: currency})
+			}
+		}
+	}
+
+	s.logger.Info().Ctx(ctx).
+		Int("added_pairs", len(change.AddPairs)).
+		Int("removed_pairs", len(change.RemovePairs)).
+		Strs("added_bases", change.AddBases).
+		Strs("removed_bases", change.RemoveBases).
+		Int("stored_pairs", len(storedPairs)).
+		Msg("exchange rate subscription changed")
+	return batch, nil
+}
+
+// GetRateAsOf returns the rate the pair had at the given time
+func (s *BankExchangeRateService) GetRateAsOf(ctx context.Context, pair entities.CurrencyPair, at time.Time) (entities.ExchangeRate, error) {
+	sCtx, nSpan := otel.Tracer("GetRateAsOf").Start(ctx, "GetRateAsOf.service.span")
+	defer nSpan.End()
+
+	exRate, err := s.rates.asOf(sCtx, pair, at)
+	if err != nil {
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to get exchange rate")
+		return entities.ExchangeRate{}, err
+	}
+	return exRate.toExchangeRate(), nil
+}
+
+// maxHistoryCandles bounds the number of intervals of a history request
+const maxHistoryCandles = 1000
+
+// GetRateHistory returns a candle for every interval between start and end, starting with the first interval the pair had a rate in.
+// The history covers the stored rates and their inverses, the cross rates have no history.
+func (s *BankExchangeRateService) GetRateHistory(ctx context.Context, pair entities.CurrencyPair, start time.Time, end time.Time, interval time.Duration) ([]entities.ExchangeRateCandle, error) {
+	sCtx, nSpan := otel.Tracer("GetRateHistory").Start(ctx, "GetRateHistory.service.span")
+	defer nSpan.End()
+
+	if !end.After(start) {
+		return nil, domainErrors.InvalidInputError("end time should be after start time")
+	}
+	count := int((end.Sub(start) + interval - 1) / interval)
+	if count > maxHistoryCandles {
+		return nil, domainErrors.InvalidInputError(fmt.Sprintf("the time range covers %d intervals, at most %d are allowed", count, maxHistoryCandles))
+	}
+
+	stored := storedPair(pair.From, pair.To)
+	inverted := stored != pair
+	opening, buckets, err := s.port.GetHistory(sCtx, stored.From, stored.To, start, end, interval)
+	if err != nil {
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to get exchange rate history")
+		return nil, err
+	}
+
+	// the intervals without any change carry the rate in effect over from the previous interval
+	var previous *big.Rat
+	if opening != nil {
+		if previous, err = entities.ParseRate(opening.Rate); err != nil {
+			return nil, err
+		}
+		if inverted {
+			previous.Inv(previous)
+		}
+	}
+	candles := make([]entities.ExchangeRateCandle, 0, count)
+	for i, next := 0, 0; i < count; i++ {
+		candleStart := start.Add(time.Duration(i) * interval)
+		candle := entities.ExchangeRateCandle{Start: candleStart, End: candleStart.Add(interval)}
+		if candle.End.After(end) {
+			candle.End = end
+		}
+
+		if next < len(buckets) && buckets[next].Bucket == int64(i) {
+			bucket := buckets[next]
+			next++
+			open, high, low, closing, err := parseBucketRates(bucket, inverted)
+			if err != nil {
+				return nil, err
+			}
+			if previous != nil {
+				open = previous
+				high = maxRate(high, previous)
+				low = minRate(low, previous)
+			}
+			candle.Open, candle.High, candle.Low, candle.Close = formatRate(open), formatRate(high), formatRate(low), formatRate(closing)
+			candle.Changes = bucket.Changes
+			previous = closing
+		} else if previous != nil {
+			rate := formatRate(previous)
+			candle.Open, candle.High, candle.Low, candle.Close = rate, rate, rate, rate
+		} else {
+			// the pair had no rate yet
+			continue
+		}
+		candles = append(candles, candle)
+	}
+	return candles, nil
+}
+
+// parseBucketRates parses the rates of the bucket, the inverse of the highest stored rate is the lowest rate of an inverted pair
+func parseBucketRates(bucket adapters.ExchangeRateBucketModel, inverted bool) (open, high, low, closing *big.Rat, err error) {
+	rates := []*big.Rat{}
+	for _, rate := range []string{bucket.Open, bucket.High, bucket.Low, bucket.Close} {
+		parsed, err := entities.ParseRate(rate)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		if inverted {
+			parsed.Inv(parsed)
+		}
+		rates = append(rates, parsed)
+	}
+	if inverted {
+		return rates[0], rates[2], rates[1], rates[3], nil
+	}
+	return rates[0], rates[1], rates[2], rates[3], nil
+}
+
+func maxRate(a *big.Rat, b *big.Rat) *big.Rat {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
+
+func minRate(a *big.Rat, b *big.Rat) *big.Rat {
+	if a.Cmp(b) <= 0 {
+		return a
+	}
+	return b
+}
+
+// formatRate writes the rate with the 10 decimal digits of the stored rates
+func formatRate(rate *big.Rat) string {
+	return rate.FloatString(10)
+}
+
+func convertWithRate(exRate *resolvedRate, amount entities.Money, roundingMode entities.RoundingMode) (entities.ExchangeRateQuote, error) {
+	converted, err := amount.Convert(exRate.rate, exRate.pair.To, roundingMode)
+	if err != nil {
+		return entities.ExchangeRateQuote{}, err
+	}
+	return entities.ExchangeRateQuote{
+		Amount:    converted,
+		Rate:      formatRate(exRate.rate),
+		ValidFrom: exRate.validFrom,
+		ValidTo:   exRate.validTo,
+		Sequence:  exRate.sequence,
+		Path:      exRate.path,
+	}, nil
 }
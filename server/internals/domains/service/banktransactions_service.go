@@ -5,6 +5,7 @@ import (
 	"time"
 
 	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	domainErrors "github.com/cybrarymin/gRPC/server/internals/domains/errors"
 	ports "github.com/cybrarymin/gRPC/server/internals/domains/ports"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
@@ -15,18 +16,26 @@ import (
 type TransactionService struct {
 	ports.TransactionRepositoryPort
 	ports.BankAccountRepositoryPort
+	ports.UnitOfWorkPort
+	ports.IdempotencyKeyRepositoryPort
+	ports.LedgerRepositoryPort
 	*zerolog.Logger
 }
 
-func NewTransactionService(repoPort ports.TransactionRepositoryPort, accountPort ports.BankAccountRepositoryPort, logger *zerolog.Logger) *TransactionService {
+func NewTransactionService(repoPort ports.TransactionRepositoryPort, accountPort ports.BankAccountRepositoryPort, uowPort ports.UnitOfWorkPort, idempotencyPort ports.IdempotencyKeyRepositoryPort, ledgerPort ports.LedgerRepositoryPort, logger *zerolog.Logger) *TransactionService {
 	return &TransactionService{
 		repoPort,
 		accountPort,
+		uowPort,
+		idempotencyPort,
+		ledgerPort,
 		logger,
 	}
 }
 
-func (s *TransactionService) NewTransaction(ctx context.Context, accUUID uuid.UUID, amount float64, TRType string, note string) (*domains.BankTransaction, error) {
+// NewTransaction applies the transaction to the account balance, records it and posts it to the ledger against the external system account.
+// A non-empty idempotencyKey makes retries safe: replaying the key with the same payload returns the original transaction.
+func (s *TransactionService) NewTransaction(ctx context.Context, accUUID uuid.UUID, amount domains.Money, TRType string, note string, idempotencyKey string) (*domains.BankTransaction, error) {
 	sCtx, nSpan := otel.Tracer("NewTransaction").Start(ctx, "NewTransaction.service.span")
 	defer nSpan.End()
 
@@ -41,96 +50,210 @@ func (s *TransactionService) NewTransaction(ctx context.Context, accUUID uuid.UU
 	}
 
 	startTime := time.Now()
-	nAccountModel, err := s.GetByID(sCtx, accUUID)
+	var nAccount *domains.BankAccount
+	var replayedTransaction *domains.BankTransaction
+
+	// balance update, the transaction record and its journal entry are committed together.
+	// when called with a transactional context it joins the caller transaction
+	err := s.WithinTransaction(sCtx, func(txCtx context.Context) error {
+		if idempotencyKey != "" {
+			originalUUID, err := reserveIdempotencyKey(txCtx, s.IdempotencyKeyRepositoryPort, domains.IdempotencyOpCreateTransaction, idempotencyKey,
+				requestHash(accUUID.String(), amount.String(), TRType, note))
+			if err != nil {
+				s.Logger.Error().Ctx(txCtx).
+					Err(err).
+					Str("account_uuid", accUUID.String()).
+					Str("idempotency_key", idempotencyKey).
+					Msg("failed to reserve transaction idempotency key")
+				nSpan.RecordError(err)
+				nSpan.SetStatus(codes.Error, "failed to reserve idempotency key")
+				return err
+			}
+
+			// the key has already been used with the same payload, return the original transaction without touching the balance
+			if originalUUID != uuid.Nil {
+				originalTransaction, err := s.GetTransactionByID(txCtx, originalUUID)
+				if err != nil {
+					nSpan.RecordError(err)
+					nSpan.SetStatus(codes.Error, "failed to get the original transaction of the idempotency key")
+					return err
+				}
+				replayedTransaction, err = originalTransaction.ToBankTransaction()
+				return err
+			}
+		}
+
+		var err error
+		nAccount, err = s.applyTransaction(txCtx, nTransaction)
+		if err != nil {
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "failed to apply transaction")
+			return err
+		}
+
+		entry := domains.NewAccountBalanceEntry(domains.LedgerEntryTransaction, nTransaction.TransactionUUID, accUUID, nTransaction.BalanceChange(), note)
+		if err = postJournalEntry(txCtx, s.LedgerRepositoryPort, entry); err != nil {
+			s.Logger.Error().Ctx(txCtx).
+				Err(err).
+				Str("transaction_uuid", nTransaction.TransactionUUID.String()).
+				Msg("failed to post transaction to the ledger")
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "failed to post transaction to the ledger")
+			return err
+		}
+
+		if idempotencyKey != "" {
+			err = setIdempotencyKeyResource(txCtx, s.IdempotencyKeyRepositoryPort, domains.IdempotencyOpCreateTransaction, idempotencyKey, nTransaction.TransactionUUID)
+			if err != nil {
+				nSpan.RecordError(err)
+				nSpan.SetStatus(codes.Error, "failed to store idempotency key result")
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if replayedTransaction != nil {
+		s.Logger.Info().Ctx(sCtx).
+			Str("transaction_uuid", replayedTransaction.TransactionUUID.String()).
+			Str("idempotency_key", idempotencyKey).
+			Msg("idempotency key replayed, returning the original transaction")
+		return replayedTransaction, nil
+	}
+
+	// INFO log for overall success
+	s.Logger.Info().Ctx(sCtx).
+		Str("transaction_uuid", nTransaction.TransactionUUID.String()).
+		Str("account_uuid", nTransaction.AccountUUID.String()).
+		Str("transaction_type", nTransaction.TransactionType).
+		Str("amount", nTransaction.Amount.String()).
+		Str("new_balance", nAccount.CurrentBalance.String()).
+		Dur("total_duration_ms", time.Since(startTime)).
+		Msg("transaction completed successfully")
+
+	return nTransaction, nil
+}
+
+// GetTransaction returns a single transaction by its uuid. Customers only get the transactions of their own accounts.
+func (s *TransactionService) GetTransaction(ctx context.Context, transactionUUID uuid.UUID) (*domains.BankTransaction, error) {
+	sCtx, nSpan := otel.Tracer("GetTransaction").Start(ctx, "GetTransaction.service.span")
+	defer nSpan.End()
+
+	transactionModel, err := s.GetTransactionByID(sCtx, transactionUUID)
 	if err != nil {
-		s.Logger.Error().
-			Err(err).
-			Str("account_uuid", accUUID.String()).
-			Dur("duration_ms", time.Since(startTime)).
-			Msg("failed to retrieve account details")
 		nSpan.RecordError(err)
-		nSpan.SetStatus(codes.Error, "failed to get user information")
+		nSpan.SetStatus(codes.Error, "failed to get transaction")
 		return nil, err
 	}
 
-	nAccount := &domains.BankAccount{
-		AccountUUID:    nAccountModel.AccountUUID,
-		AccountNumber:  nAccountModel.AccountNumber,
-		AccountName:    nAccountModel.AccountName,
-		Currency:       nAccountModel.Currency,
-		CurrentBalance: nAccountModel.CurrentBalance,
-		CreatedAt:      nAccountModel.CreatedAt,
-		UpdatedAt:      nAccountModel.UpdatedAt,
+	if err := authorizeAccountUUID(sCtx, s.BankAccountRepositoryPort, transactionModel.AccountUUID); err != nil {
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "caller isn't allowed to read the transaction")
+		return nil, err
 	}
 
-	startTime = time.Now()
-	switch nTransaction.TransactionType {
-	case domains.TRDepositType:
-		nAccount.CurrentBalance += amount
+	return transactionModel.ToBankTransaction()
+}
 
-		_, err := s.Update(sCtx, accUUID, nAccount)
-		if err != nil {
+// ListTransactions returns a page of transactions matching the filter, newest first, and the token of the next page.
+// An empty next page token means there are no more transactions. Customers only get the transactions of their own accounts.
+func (s *TransactionService) ListTransactions(ctx context.Context, filter *domains.TransactionFilter, pageSize int, pageToken string) (domains.BankTransactions, string, error) {
+	sCtx, nSpan := otel.Tracer("ListTransactions").Start(ctx, "ListTransactions.service.span")
+	defer nSpan.End()
 
-			// ERROR log if update fails
-			s.Logger.Error().
-				Err(err).
-				Str("account_uuid", accUUID.String()).
-				Str("transaction_type", nTransaction.TransactionType).
-				Float64("amount", amount).
-				Float64("attempted_balance", nAccount.CurrentBalance).
-				Msg("failed to update account balance")
-			nSpan.RecordError(err)
-			nSpan.SetStatus(codes.Error, "failed to update account balance during transaction")
-			return nil, err
-		}
+	cursor, err := domains.DecodePageToken(pageToken)
+	if err != nil {
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "invalid page token")
+		return nil, "", err
+	}
+	if filter.MinAmount != nil && filter.MaxAmount != nil && filter.MinAmount.Currency != filter.MaxAmount.Currency {
+		err := domainErrors.InvalidInputError("min and max amount should have the same currency")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "invalid amount range")
+		return nil, "", err
+	}
+	if scope := ownerScope(sCtx); scope != "" {
+		filter.OwnerSubject = scope
+	}
 
-	default:
-		nAccount.CurrentBalance -= amount
+	// fetch one extra row to find out whether there is a next page
+	pageSize = domains.NormalizePageSize(pageSize)
+	transactionModels, err := s.TransactionRepositoryPort.ListTransactions(sCtx, filter, cursor, pageSize+1)
+	if err != nil {
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to list transactions")
+		return nil, "", err
+	}
 
-		_, err := s.Update(sCtx, accUUID, nAccount)
+	nextPageToken := ""
+	if len(transactionModels) > pageSize {
+		transactionModels = transactionModels[:pageSize]
+		last := transactionModels[pageSize-1]
+		nextPageToken = (&domains.PageCursor{
+			Timestamp: last.TransactionTimestamp,
+			UUID:      last.TransactionUUID,
+		}).EncodePageToken()
+	}
 
+	transactions := make(domains.BankTransactions, 0, len(transactionModels))
+	for i := range transactionModels {
+		transaction, err := transactionModels[i].ToBankTransaction()
 		if err != nil {
-			// ERROR log if update fails
-			s.Logger.Error().
-				Err(err).
-				Str("account_uuid", accUUID.String()).
-				Str("transaction_type", nTransaction.TransactionType).
-				Float64("amount", amount).
-				Float64("attempted_balance", nAccount.CurrentBalance).
-				Msg("failed to update account balance")
-
 			nSpan.RecordError(err)
-			nSpan.SetStatus(codes.Error, "failed to update account balance during transaction")
-			return nil, err
+			nSpan.SetStatus(codes.Error, "failed to convert transaction")
+			return nil, "", err
 		}
+		transactions = append(transactions, *transaction)
+	}
+
+	s.Logger.Debug().Ctx(sCtx).
+		Str("account_uuid", filter.AccountUUID.String()).
+		Int("count", len(transactions)).
+		Bool("has_next_page", nextPageToken != "").
+		Msg("listed transactions")
+	return transactions, nextPageToken, nil
+}
+
+// applyTransaction changes the account balance and records the transaction without posting it to the ledger.
+// It must run inside a unit of work whose caller posts the matching journal entry.
+func (s *TransactionService) applyTransaction(txCtx context.Context, nTransaction *domains.BankTransaction) (*domains.BankAccount, error) {
+	startTime := time.Now()
+
+	// debits are applied with a conditional update so the balance can't go below the account overdraft limit
+	nAccountModel, err := s.UpdateBalance(txCtx, nTransaction.AccountUUID, nTransaction.BalanceChange())
+	if err != nil {
+		s.Logger.Error().Ctx(txCtx).
+			Err(err).
+			Str("account_uuid", nTransaction.AccountUUID.String()).
+			Str("transaction_type", nTransaction.TransactionType).
+			Str("amount", nTransaction.Amount.String()).
+			Dur("duration_ms", time.Since(startTime)).
+			Msg("failed to update account balance")
+		return nil, err
+	}
+
+	nAccount, err := nAccountModel.ToBankAccount()
+	if err != nil {
+		return nil, err
 	}
 
 	txnStartTime := time.Now()
 
-	createdTransaction, err := s.CreateTransaction(sCtx, nTransaction)
+	createdTransaction, err := s.CreateTransaction(txCtx, nTransaction)
 	if err != nil {
-		s.Logger.Error().
+		s.Logger.Error().Ctx(txCtx).
 			Err(err).
 			Str("account_uuid", nTransaction.AccountUUID.String()).
 			Str("transaction_type", nTransaction.TransactionType).
-			Float64("amount", nTransaction.Amount).
+			Str("amount", nTransaction.Amount.String()).
 			Dur("duration_ms", time.Since(txnStartTime)).
 			Msg("failed to create transaction record")
-		nSpan.RecordError(err)
-		nSpan.SetStatus(codes.Error, "failed create new transaction")
 		return nil, err
 	}
-
-	// INFO log for overall success
-	s.Logger.Info().
-		Str("transaction_uuid", createdTransaction.TransactionUUID.String()).
-		Str("account_uuid", nTransaction.AccountUUID.String()).
-		Str("transaction_type", nTransaction.TransactionType).
-		Float64("amount", nTransaction.Amount).
-		Float64("new_balance", nAccount.CurrentBalance).
-		Dur("total_duration_ms", time.Since(startTime)).
-		Msg("transaction completed successfully")
-
 	nTransaction.TransactionUUID = createdTransaction.TransactionUUID
-	return nTransaction, nil
+	return nAccount, nil
 }
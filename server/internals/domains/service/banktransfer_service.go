@@ -2,7 +2,6 @@ package domains
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
@@ -15,165 +14,464 @@ import (
 	"go.opentelemetry.io/otel/codes"
 )
 
-var (
-	ErrInsufficientBalance = errors.New("insufficient balance")
-)
-
 type BankTransferService struct {
-	port             ports.BankTransferRepositoryPort
-	accountPort      ports.BankAccountRepositoryPort
-	transactionPort  ports.TransactionRepositoryPort
-	exchangeRatePort ports.BankExchangeRateRepositoryPort
-	logger           *zerolog.Logger
-	validator        *Validator
+	port            ports.BankTransferRepositoryPort
+	accountPort     ports.BankAccountRepositoryPort
+	transactionPort ports.TransactionRepositoryPort
+	rates           *RateResolver
+	uowPort         ports.UnitOfWorkPort
+	idempotencyPort ports.IdempotencyKeyRepositoryPort
+	ledgerPort      ports.LedgerRepositoryPort
+	logger          *zerolog.Logger
 }
 
-func NewBankTransferService(port ports.BankTransferRepositoryPort, accountPort ports.BankAccountRepositoryPort, transactionPort ports.TransactionRepositoryPort, exchangeRatePort ports.BankExchangeRateRepositoryPort, logger *zerolog.Logger, validator *Validator) *BankTransferService {
+func NewBankTransferService(port ports.BankTransferRepositoryPort, accountPort ports.BankAccountRepositoryPort, transactionPort ports.TransactionRepositoryPort, rates *RateResolver, uowPort ports.UnitOfWorkPort, idempotencyPort ports.IdempotencyKeyRepositoryPort, ledgerPort ports.LedgerRepositoryPort, logger *zerolog.Logger) *BankTransferService {
 	logger.Debug().Msg("Initializing BankTransferService")
 	return &BankTransferService{
 		port,
 		accountPort,
 		transactionPort,
-		exchangeRatePort,
+		rates,
+		uowPort,
+		idempotencyPort,
+		ledgerPort,
 		logger,
-		validator,
 	}
 }
 
-func (s *BankTransferService) TransferMoney(ctx context.Context, srcAccount uuid.UUID, dstAccount uuid.UUID, currency string, amount float64) (*domains.BankTransfer, error) {
+// TransferMoney moves amount, expressed in the destination account currency, from the source to the destination account.
+// A non-empty idempotencyKey makes retries safe: replaying the key with the same payload returns the original transfer.
+// The transfer walks through pending, debited and completed while the money moves. When the money can't be moved the
+// transfer is recorded as failed with the reason and returned along with the error. Customers may only send money from their own accounts.
+func (s *BankTransferService) TransferMoney(ctx context.Context, srcAccount uuid.UUID, dstAccount uuid.UUID, amount domains.Money, idempotencyKey string) (*domains.BankTransfer, error) {
 	sCtx, nSpan := otel.Tracer("TransferMoney").Start(ctx, "TransferMoney.service.span")
 	defer nSpan.End()
 
-	s.logger.Info().
+	s.logger.Info().Ctx(sCtx).
 		Str("source_account", srcAccount.String()).
 		Str("destination_account", dstAccount.String()).
-		Str("currency", currency).
-		Float64("amount", amount).
+		Str("amount", amount.String()).
 		Msg("Starting money transfer")
 
-	startTime := time.Now()
-
-	nTransfer := &domains.BankTransfer{
-		FromAccountUUID:   srcAccount,
-		ToAccountUUID:     dstAccount,
-		Currency:          currency,
-		Amount:            amount,
-		TransferTimestamp: startTime,
-		TransferSucceed:   false,
-		CreatedAt:         startTime,
-		UpdatedAt:         startTime,
+	// rejected callers never leave a transfer record behind
+	if err := authorizeAccountUUID(sCtx, s.accountPort, srcAccount); err != nil {
+		s.logger.Warn().Ctx(sCtx).Err(err).
+			Str("source_account", srcAccount.String()).
+			Msg("caller isn't allowed to send money from the source account")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "caller isn't allowed to debit the source account")
+		return nil, err
 	}
 
-	createdTransfer, err := s.port.CreateTransfer(sCtx, nTransfer)
+	nTransfer := newPendingTransfer(srcAccount, dstAccount, amount)
+
+	var replayedTransfer *domains.BankTransfer
+
+	// transfer record, status history, debit, credit and both transaction records are committed or rolled back as a single unit
+	err := s.uowPort.WithinTransaction(sCtx, func(txCtx context.Context) error {
+		if idempotencyKey != "" {
+			originalUUID, err := reserveIdempotencyKey(txCtx, s.idempotencyPort, domains.IdempotencyOpCreateTransfer, idempotencyKey,
+				requestHash(srcAccount.String(), dstAccount.String(), amount.String()))
+			if err != nil {
+				s.logger.Error().Ctx(txCtx).
+					Err(err).
+					Str("idempotency_key", idempotencyKey).
+					Msg("failed to reserve transfer idempotency key")
+				nSpan.RecordError(err)
+				nSpan.SetStatus(codes.Error, "failed to reserve idempotency key")
+				return err
+			}
+
+			// the key has already been used with the same payload, return the original transfer without moving money again
+			if originalUUID != uuid.Nil {
+				originalTransfer, err := s.port.GetTransferByID(txCtx, originalUUID)
+				if err != nil {
+					nSpan.RecordError(err)
+					nSpan.SetStatus(codes.Error, "failed to get the original transfer of the idempotency key")
+					return err
+				}
+				replayedTransfer, err = originalTransfer.ToBankTransfer()
+				return err
+			}
+		}
+
+		_, err := s.port.CreateTransfer(txCtx, nTransfer)
+		if err != nil {
+			s.logger.Error().Ctx(txCtx).Err(err).Msg("failed to create a tranfer object in database")
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "failed to create new transfer object in database")
+			return err
+		}
+
+		dstAccountInfo, err := s.accountPort.GetByID(txCtx, dstAccount)
+		if err != nil {
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "failed to get destination account information of the money transfer request")
+			return err
+		}
+
+		if dstAccountInfo.Currency != amount.Currency {
+			err = domainErrors.InvalidCurrencyError(amount.Currency)
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "non-compliant destination account currency with tranfer request currency")
+			return err
+		}
+
+		srcAccountInfo, err := s.accountPort.GetByID(txCtx, srcAccount)
+		if err != nil {
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "failed to get source account information of the money transfer request")
+			return err
+		}
+
+		exchangeRate, err := s.rates.current(txCtx, domains.CurrencyPair{From: dstAccountInfo.Currency, To: srcAccountInfo.Currency})
+		if err != nil {
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "failed to get currencies exchange rate to convert destination account curreny to source account currency")
+			return err
+		}
+		rate := exchangeRate.rate
+
+		// destination account receives exactly the requested amount, the source account is debited with its equivalent
+		// in the source currency rounded up to the next minor unit so the bank never pays out more than it collects
+		debitAmount, err := amount.Convert(rate, srcAccountInfo.Currency, domains.RoundUp)
+		if err != nil {
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "failed to convert transfer amount to source account currency")
+			return err
+		}
+		err = s.applyTransferLeg(txCtx, srcAccount, debitAmount, domains.TRTransferType, fmt.Sprintf("transfer to account %s", dstAccount.String()))
+		if err != nil {
+			s.logger.Error().Ctx(txCtx).
+				Err(err).
+				Str("account", srcAccount.String()).
+				Str("amount", debitAmount.String()).
+				Msg("Failed to deduct amount from source account")
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "failed to deduct amount from source account during money transfer")
+			return err
+		}
+
+		nTransfer.DebitAmount = &debitAmount
+		if err = s.changeStatus(txCtx, nTransfer, domains.TransferStatusDebited, ""); err != nil {
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "failed to change transfer status to debited")
+			return err
+		}
+
+		err = s.applyTransferLeg(txCtx, dstAccount, amount, domains.TRDepositType, fmt.Sprintf("transfer from account %s", srcAccount.String()))
+		if err != nil {
+			s.logger.Error().Ctx(txCtx).
+				Err(err).
+				Str("account", dstAccount.String()).
+				Str("amount", amount.String()).
+				Msg("Failed to add amount to destination account")
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "failed to add amount from destination account during money transfer")
+			return err
+		}
+
+		if err = s.postTransferEntry(txCtx, nTransfer, false); err != nil {
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "failed to post transfer to the ledger")
+			return err
+		}
+
+		if err = s.changeStatus(txCtx, nTransfer, domains.TransferStatusCompleted, ""); err != nil {
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "failed to change transfer status to completed")
+			return err
+		}
+
+		if idempotencyKey != "" {
+			err = setIdempotencyKeyResource(txCtx, s.idempotencyPort, domains.IdempotencyOpCreateTransfer, idempotencyKey, nTransfer.TransferUUID)
+			if err != nil {
+				nSpan.RecordError(err)
+				nSpan.SetStatus(codes.Error, "failed to store idempotency key result")
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		s.logger.Error().Err(err).Msg("failed to create a tranfer object in database")
-		nSpan.RecordError(err)
-		nSpan.SetStatus(codes.Error, "failed to create new transfer object in database")
-		return nil, err
+		return s.recordFailedTransfer(sCtx, nTransfer, err), err
 	}
 
-	dstAccountInfo, err := s.accountPort.GetByID(sCtx, dstAccount)
+	if replayedTransfer != nil {
+		s.logger.Info().Ctx(sCtx).
+			Str("transfer_id", replayedTransfer.TransferUUID.String()).
+			Str("idempotency_key", idempotencyKey).
+			Msg("idempotency key replayed, returning the original transfer")
+		return replayedTransfer, nil
+	}
+
+	s.logger.Info().Ctx(sCtx).
+		Str("transfer_id", nTransfer.TransferUUID.String()).
+		Str("from_account", srcAccount.String()).
+		Str("to_account", dstAccount.String()).
+		Str("amount", amount.String()).
+		Msg("Money transfer completed successfully")
+	recordTransfer(sCtx, transferOutcomeCompleted, amount)
+
+	return nTransfer, nil
+}
+
+// recordFailedTransfer stores the aborted transfer as failed with the cause of the failure, so operators can find out why it failed.
+// The money movement has already been rolled back, the failed transfer is written in its own transaction.
+// Requests referring to unknown accounts or reusing an idempotency key are not recorded. It returns nil when nothing was recorded.
+func (s *BankTransferService) recordFailedTransfer(ctx context.Context, aborted *domains.BankTransfer, cause error) *domains.BankTransfer {
+	if domainErrors.IsNotFound(cause) || domainErrors.IsIdempotencyKeyReused(cause) {
+		return nil
+	}
+
+	failedTransfer := newPendingTransfer(aborted.FromAccountUUID, aborted.ToAccountUUID, aborted.Amount)
+	failedTransfer.TransferUUID = aborted.TransferUUID
+
+	err := s.uowPort.WithinTransaction(context.WithoutCancel(ctx), func(txCtx context.Context) error {
+		if _, err := s.port.CreateTransfer(txCtx, failedTransfer); err != nil {
+			return err
+		}
+		return s.changeStatus(txCtx, failedTransfer, domains.TransferStatusFailed, cause.Error())
+	})
 	if err != nil {
-		nSpan.RecordError(err)
-		nSpan.SetStatus(codes.Error, "failed to get destination account information of the money transfer request")
-		return nil, err
+		s.logger.Error().Ctx(ctx).
+			Err(err).
+			Str("transfer_id", failedTransfer.TransferUUID.String()).
+			Str("failure_reason", cause.Error()).
+			Msg("failed to record the failed transfer")
+		return nil
 	}
 
-	if dstAccountInfo.Currency != currency {
+	s.logger.Warn().Ctx(ctx).
+		Str("transfer_id", failedTransfer.TransferUUID.String()).
+		Str("from_account", failedTransfer.FromAccountUUID.String()).
+		Str("to_account", failedTransfer.ToAccountUUID.String()).
+		Str("failure_reason", cause.Error()).
+		Msg("Money transfer failed")
+	recordTransfer(ctx, transferOutcomeFailed, failedTransfer.Amount)
+	return failedTransfer
+}
+
+// GetTransfer returns a transfer along with its status history. Customers only get the transfers sent or received by their own accounts.
+func (s *BankTransferService) GetTransfer(ctx context.Context, transferUUID uuid.UUID) (*domains.BankTransfer, error) {
+	sCtx, nSpan := otel.Tracer("GetTransfer").Start(ctx, "GetTransfer.service.span")
+	defer nSpan.End()
+
+	transferModel, err := s.port.GetTransferByID(sCtx, transferUUID)
+	if err != nil {
 		nSpan.RecordError(err)
-		nSpan.SetStatus(codes.Error, "non-compliant destination account currency with tranfer request currency")
-		return nil, domainErrors.InvalidCurrencyError(currency)
+		nSpan.SetStatus(codes.Error, "failed to get transfer")
+		return nil, err
 	}
 
-	srcAccountInfo, err := s.accountPort.GetByID(sCtx, srcAccount)
+	transfer, err := transferModel.ToBankTransfer()
 	if err != nil {
 		nSpan.RecordError(err)
-		nSpan.SetStatus(codes.Error, "failed to get source account information of the money transfer request")
+		nSpan.SetStatus(codes.Error, "failed to convert transfer")
 		return nil, err
 	}
 
-	exchangeRate, err := s.exchangeRatePort.GetByCurrencies(sCtx, srcAccountInfo.Currency, dstAccountInfo.Currency)
+	// a customer may see the transfer from either side
+	if err := authorizeAccountUUID(sCtx, s.accountPort, transfer.FromAccountUUID); err != nil {
+		if !domainErrors.IsNotAccountOwner(err) {
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "failed to authorize the transfer read")
+			return nil, err
+		}
+		if err := authorizeAccountUUID(sCtx, s.accountPort, transfer.ToAccountUUID); err != nil {
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "caller isn't allowed to read the transfer")
+			return nil, err
+		}
+	}
+
+	history, err := s.port.GetTransferHistory(sCtx, transferUUID)
 	if err != nil {
 		nSpan.RecordError(err)
-		nSpan.SetStatus(codes.Error, "failed to get currencies exchange rate to convert source account curreny to destination account currency")
+		nSpan.SetStatus(codes.Error, "failed to get transfer status history")
 		return nil, err
 	}
+	transfer.History = history.ToStatusChanges()
 
-	transferAmount := exchangeRate.Rate * amount
-	nTransaction := NewTransactionService(s.transactionPort, s.accountPort, s.logger)
+	return transfer, nil
+}
+
+// ListTransfers returns one page of transfers matching the filter, newest first, and the token of the next page.
+// An empty next page token means there are no more transfers. The status history is only returned by GetTransfer.
+// Customers only get the transfers sent or received by their own accounts.
+func (s *BankTransferService) ListTransfers(ctx context.Context, filter *domains.TransferFilter, pageSize int, pageToken string) (domains.BankTransfers, string, error) {
+	sCtx, nSpan := otel.Tracer("ListTransfers").Start(ctx, "ListTransfers.service.span")
+	defer nSpan.End()
 
-	_, err = nTransaction.NewTransaction(sCtx, srcAccount, transferAmount, "Transfer", fmt.Sprintf("transfer to account %s", dstAccount.String()))
+	cursor, err := domains.DecodePageToken(pageToken)
 	if err != nil {
-		s.logger.Error().
-			Err(err).
-			Str("account", srcAccount.String()).
-			Float64("amount", amount).
-			Msg("Failed to deduct amount from source account")
 		nSpan.RecordError(err)
-		nSpan.SetStatus(codes.Error, "failed to deduct amount from source account during money transfer")
-		return nil, err
+		nSpan.SetStatus(codes.Error, "invalid page token")
+		return nil, "", err
+	}
+	if filter.Status != "" {
+		if err := domains.ValidTransferStatus(filter.Status); err != nil {
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "invalid transfer status filter")
+			return nil, "", err
+		}
+	}
+	if scope := ownerScope(sCtx); scope != "" {
+		filter.OwnerSubject = scope
 	}
 
-	_, err = nTransaction.NewTransaction(ctx, dstAccount, transferAmount, "Deposit", fmt.Sprintf("transfer from account %s", srcAccount.String()))
+	// fetch one extra row to find out whether there is a next page
+	pageSize = domains.NormalizePageSize(pageSize)
+	transferModels, err := s.port.ListTransfers(sCtx, filter, cursor, pageSize+1)
 	if err != nil {
-		s.logger.Error().
-			Err(err).
-			Str("account", dstAccount.String()).
-			Float64("amount", amount).
-			Msg("Failed to add amount to destination account")
 		nSpan.RecordError(err)
-		nSpan.SetStatus(codes.Error, "failed to add amount from destination account during money transfer")
-		return nil, err
+		nSpan.SetStatus(codes.Error, "failed to list transfers")
+		return nil, "", err
 	}
 
-	nTransfer.TransferSucceed = true
-	nTransfer.TransferUUID = createdTransfer.TransferUUID
-	_, err = s.port.UpdateTransfer(sCtx, nTransfer.TransferUUID, nTransfer)
+	nextPageToken := ""
+	if len(transferModels) > pageSize {
+		transferModels = transferModels[:pageSize]
+		last := transferModels[pageSize-1]
+		nextPageToken = (&domains.PageCursor{
+			Timestamp: last.TransferTimestamp,
+			UUID:      last.TransferUUID,
+		}).EncodePageToken()
+	}
 
-	if err != nil {
-		s.logger.Error().
-			Err(err).
-			Str("from_account", srcAccount.String()).
-			Str("to_account", dstAccount.String()).
-			Float64("amount", amount).
-			Msg("Failed to create transfer record, attempting rollback")
-
-		_, rollbackErr := nTransaction.NewTransaction(ctx, srcAccount, amount, "Deposit", fmt.Sprintf("rollback transfer to account %s", dstAccount.String()))
-		if rollbackErr != nil {
-			s.logger.Error().
-				Err(rollbackErr).
-				Str("account", srcAccount.String()).
-				Float64("amount", amount).
-				Msg("Failed to rollback source account transaction")
+	transfers := make(domains.BankTransfers, 0, len(transferModels))
+	for i := range transferModels {
+		transfer, err := transferModels[i].ToBankTransfer()
+		if err != nil {
 			nSpan.RecordError(err)
-			nSpan.SetStatus(codes.Error, "failed to rollback source account transaction after money transfer failure")
-			return nil, fmt.Errorf("transfer failed and rollback failed: %w", rollbackErr)
+			nSpan.SetStatus(codes.Error, "failed to convert transfer")
+			return nil, "", err
 		}
+		transfers = append(transfers, *transfer)
+	}
 
-		_, rollbackErr = nTransaction.NewTransaction(ctx, dstAccount, amount, "Transfer", fmt.Sprintf("rollback transfer from account %s", srcAccount.String()))
-		if rollbackErr != nil {
-			s.logger.Error().
-				Err(rollbackErr).
-				Str("account", dstAccount.String()).
-				Float64("amount", amount).
-				Msg("Failed to rollback destination account transaction")
-			nSpan.RecordError(err)
-			nSpan.SetStatus(codes.Error, "failed to rollback destination account transaction after money transfer failure")
-			return nil, fmt.Errorf("transfer failed and rollback partially failed: %w", rollbackErr)
+	return transfers, nextPageToken, nil
+}
+
+// ReverseTransfer pays a completed transfer back: the destination account is debited with the credited amount and
+// the source account is credited with the debited amount, so neither side is affected by exchange rate changes.
+func (s *BankTransferService) ReverseTransfer(ctx context.Context, transferUUID uuid.UUID, reason string) (*domains.BankTransfer, error) {
+	sCtx, nSpan := otel.Tracer("ReverseTransfer").Start(ctx, "ReverseTransfer.service.span")
+	defer nSpan.End()
+
+	var transfer *domains.BankTransfer
+	err := s.uowPort.WithinTransaction(sCtx, func(txCtx context.Context) error {
+		transferModel, err := s.port.GetTransferByID(txCtx, transferUUID)
+		if err != nil {
+			return err
+		}
+		transfer, err = transferModel.ToBankTransfer()
+		if err != nil {
+			return err
+		}
+
+		if !transfer.CanTransitionTo(domains.TransferStatusReversed) {
+			return domainErrors.InvalidStatusTransitionError("bank transfer", transferUUID.String(), transfer.Status, domains.TransferStatusReversed)
+		}
+		if transfer.DebitAmount == nil {
+			return domainErrors.InvalidInputError(fmt.Sprintf("transfer %s has no recorded debit amount and can't be reversed", transferUUID.String()))
 		}
 
+		err = s.applyTransferLeg(txCtx, transfer.ToAccountUUID, transfer.Amount, domains.TRTransferType, fmt.Sprintf("reversal of transfer %s", transferUUID.String()))
+		if err != nil {
+			return err
+		}
+		err = s.applyTransferLeg(txCtx, transfer.FromAccountUUID, *transfer.DebitAmount, domains.TRRefundType, fmt.Sprintf("reversal of transfer %s", transferUUID.String()))
+		if err != nil {
+			return err
+		}
+		if err = s.postTransferEntry(txCtx, transfer, true); err != nil {
+			return err
+		}
+
+		return s.changeStatus(txCtx, transfer, domains.TransferStatusReversed, reason)
+	})
+	if err != nil {
+		s.logger.Error().Ctx(sCtx).
+			Err(err).
+			Str("transfer_id", transferUUID.String()).
+			Msg("failed to reverse transfer")
 		nSpan.RecordError(err)
-		nSpan.SetStatus(codes.Error, "failed to change transfer status successful")
+		nSpan.SetStatus(codes.Error, "failed to reverse transfer")
 		return nil, err
 	}
 
-	s.logger.Info().
-		Str("transfer_id", nTransfer.TransferUUID.String()).
-		Str("from_account", srcAccount.String()).
-		Str("to_account", dstAccount.String()).
-		Float64("amount", amount).
-		Str("currency", currency).
-		Msg("Money transfer completed successfully")
+	s.logger.Info().Ctx(sCtx).
+		Str("transfer_id", transferUUID.String()).
+		Str("reason", reason).
+		Msg("Money transfer reversed")
+	return transfer, nil
+}
 
-	return nTransfer, nil
+// applyTransferLeg changes the balance of one side of a transfer and records it as a bank transaction.
+// Both legs are posted to the ledger together by postTransferEntry.
+func (s *BankTransferService) applyTransferLeg(txCtx context.Context, accUUID uuid.UUID, amount domains.Money, trType string, note string) error {
+	now := time.Now()
+	nTransaction := NewTransactionService(s.transactionPort, s.accountPort, s.uowPort, s.idempotencyPort, s.ledgerPort, s.logger)
+	_, err := nTransaction.applyTransaction(txCtx, &domains.BankTransaction{
+		AccountUUID:          accUUID,
+		TransactionTimestamp: now,
+		Amount:               amount,
+		TransactionType:      trType,
+		Notes:                note,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	})
+	return err
+}
+
+// postTransferEntry posts the journal entry of a transfer, or of its reversal, once both legs have been applied
+func (s *BankTransferService) postTransferEntry(txCtx context.Context, transfer *domains.BankTransfer, reversal bool) error {
+	entry, err := domains.NewTransferEntry(transfer, reversal)
+	if err != nil {
+		return err
+	}
+	if err = postJournalEntry(txCtx, s.ledgerPort, entry); err != nil {
+		s.logger.Error().Ctx(txCtx).
+			Err(err).
+			Str("transfer_id", transfer.TransferUUID.String()).
+			Bool("reversal", reversal).
+			Msg("failed to post transfer to the ledger")
+		return err
+	}
+	return nil
+}
+
+// changeStatus moves the transfer to the given status and persists the change with its history entry
+func (s *BankTransferService) changeStatus(ctx context.Context, transfer *domains.BankTransfer, status string, reason string) error {
+	change, err := transfer.TransitionTo(status, reason, time.Now())
+	if err != nil {
+		return err
+	}
+	_, err = s.port.UpdateTransferStatus(ctx, transfer, change)
+	if err != nil {
+		s.logger.Error().Ctx(ctx).
+			Err(err).
+			Str("transfer_id", transfer.TransferUUID.String()).
+			Str("status", status).
+			Msg("failed to change transfer status")
+	}
+	return err
+}
+
+func newPendingTransfer(srcAccount uuid.UUID, dstAccount uuid.UUID, amount domains.Money) *domains.BankTransfer {
+	startTime := time.Now()
+	return &domains.BankTransfer{
+		TransferUUID:      uuid.New(),
+		FromAccountUUID:   srcAccount,
+		ToAccountUUID:     dstAccount,
+		Amount:            amount,
+		TransferTimestamp: startTime,
+		Status:            domains.TransferStatusPending,
+		History: []domains.TransferStatusChange{
+			{ToStatus: domains.TransferStatusPending, ChangedAt: startTime},
+		},
+		CreatedAt: startTime,
+		UpdatedAt: startTime,
+	}
 }
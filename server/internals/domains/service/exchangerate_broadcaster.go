@@ -0,0 +1,182 @@
+package domains
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	adapters "github.com/cybrarymin/gRPC/server/internals/adapters/driven_adapters/database"
+	entities "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	"github.com/rs/zerolog"
+)
+
+// RateBroadcaster fans the exchange rate changes out to the subscribers of each currency pair.
+// Publishing never blocks: a subscriber only holds the latest change of each pair it hasn't read yet, so a slow consumer skips
+// the intermediate rates and sees a gap in the sequence instead of holding back the other subscribers.
+type RateBroadcaster struct {
+	mu     sync.Mutex
+	latest map[entities.CurrencyPair]int64
+	subs   map[*RateSubscription]struct{}
+	logger *zerolog.Logger
+}
+
+func NewRateBroadcaster(logger *zerolog.Logger) *RateBroadcaster {
+	return &RateBroadcaster{
+		latest: make(map[entities.CurrencyPair]int64),
+		subs:   make(map[*RateSubscription]struct{}),
+		logger: logger,
+	}
+}
+
+// Publish hands the rate to the subscribers of its pair. Rates with a sequence already published are ignored,
+// so the same change may be published several times.
+func (b *RateBroadcaster) Publish(ctx context.Context, rate *adapters.ExchangeRateModel) {
+	pair := entities.CurrencyPair{From: rate.FromCurrency, To: rate.ToCurrency}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if latest, exists := b.latest[pair]; exists && latest >= rate.Sequence {
+		return
+	}
+	b.latest[pair] = rate.Sequence
+
+	b.logger.Debug().Ctx(ctx).
+		Str("from_currency", rate.FromCurrency).
+		Str("to_currency", rate.ToCurrency).
+		Str("rate", rate.Rate).
+		Int64("sequence", rate.Sequence).
+		Msg("publishing exchange rate change")
+	for sub := range b.subs {
+		sub.offer(ctx, pair, rate)
+	}
+}
+
+// Subscribe returns a subscription receiving the changes of the pairs published from now on. It must be closed after use.
+func (b *RateBroadcaster) Subscribe(pairs ...entities.CurrencyPair) *RateSubscription {
+	sub := &RateSubscription{
+		broadcaster: b,
+		pairs:       make(map[entities.CurrencyPair]bool),
+		pending:     make(map[entities.CurrencyPair]*adapters.ExchangeRateModel),
+		ready:       make(chan struct{}, 1),
+	}
+	sub.AddPairs(pairs...)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subs[sub] = struct{}{}
+	return sub
+}
+
+func (b *RateBroadcaster) unsubscribe(sub *RateSubscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.subs, sub)
+}
+
+// RateSubscription receives the rate changes of a set of currency pairs, the set can change at any time
+type RateSubscription struct {
+	broadcaster *RateBroadcaster
+
+	mu      sync.Mutex
+	pairs   map[entities.CurrencyPair]bool
+	pending map[entities.CurrencyPair]*adapters.ExchangeRateModel
+	ready   chan struct{}
+}
+
+func (s *RateSubscription) AddPairs(pairs ...entities.CurrencyPair) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, pair := range pairs {
+		s.pairs[pair] = true
+	}
+}
+
+// RemovePairs unsubscribes from the pairs and drops their changes waiting to be read
+func (s *RateSubscription) RemovePairs(pairs ...entities.CurrencyPair) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, pair := range pairs {
+		delete(s.pairs, pair)
+	}
+	s.dropUnwanted()
+}
+
+// Wants reports whether the changes of the pair are received
+func (s *RateSubscription) Wants(pair entities.CurrencyPair) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.wants(pair)
+}
+
+func (s *RateSubscription) wants(pair entities.CurrencyPair) bool {
+	return s.pairs[pair]
+}
+
+func (s *RateSubscription) dropUnwanted() {
+	for pair := range s.pending {
+		if !s.wants(pair) {
+			delete(s.pending, pair)
+		}
+	}
+}
+
+// offer replaces the change of the pair waiting to be read, if any
+func (s *RateSubscription) offer(ctx context.Context, pair entities.CurrencyPair, rate *adapters.ExchangeRateModel) {
+	s.mu.Lock()
+	if !s.wants(pair) {
+		s.mu.Unlock()
+		return
+	}
+	if _, exists := s.pending[pair]; exists {
+		recordSkippedRate(ctx, pair.From, pair.To)
+	}
+	s.pending[pair] = rate
+	s.mu.Unlock()
+
+	select {
+	case s.ready <- struct{}{}:
+	default:
+	}
+}
+
+// Ready is signaled when changes are waiting to be drained
+func (s *RateSubscription) Ready() <-chan struct{} {
+	return s.ready
+}
+
+// Drain returns the changes waiting to be read, ordered by pair
+func (s *RateSubscription) Drain() []*adapters.ExchangeRateModel {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rates := make([]*adapters.ExchangeRateModel, 0, len(s.pending))
+	for pair, rate := range s.pending {
+		rates = append(rates, rate)
+		delete(s.pending, pair)
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		if rates[i].FromCurrency != rates[j].FromCurrency {
+			return rates[i].FromCurrency < rates[j].FromCurrency
+		}
+		return rates[i].ToCurrency < rates[j].ToCurrency
+	})
+	return rates
+}
+
+// Next waits for the next changes of the subscribed pairs
+func (s *RateSubscription) Next(ctx context.Context) ([]*adapters.ExchangeRateModel, error) {
+	for {
+		if rates := s.Drain(); len(rates) > 0 {
+			return rates, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-s.ready:
+		}
+	}
+}
+
+func (s *RateSubscription) Close() {
+	s.broadcaster.unsubscribe(s)
+}
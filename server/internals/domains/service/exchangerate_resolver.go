@@ -0,0 +1,244 @@
+package domains
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	adapters "github.com/cybrarymin/gRPC/server/internals/adapters/driven_adapters/database"
+	entities "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	domainErrors "github.com/cybrarymin/gRPC/server/internals/domains/errors"
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/ports"
+	"github.com/rs/zerolog"
+)
+
+// RateResolver derives the exchange rate of any pair of supported currencies from the stored rates.
+// The rate of two currencies is stored once, from the alphabetically first currency to the second one, and the opposite direction
+// uses its inverse so both directions never disagree. A pair without a stored rate is crossed through the pivot currency.
+type RateResolver struct {
+	port   domains.BankExchangeRateRepositoryPort
+	pivot  string
+	logger *zerolog.Logger
+}
+
+// NewRateResolver creates a resolver crossing the pairs without a stored rate through the pivot currency. An empty pivot disables the cross rates.
+func NewRateResolver(port domains.BankExchangeRateRepositoryPort, pivot string, logger *zerolog.Logger) (*RateResolver, error) {
+	if pivot != "" {
+		if _, err := entities.CurrencyExponent(pivot); err != nil {
+			return nil, err
+		}
+	}
+	return &RateResolver{
+		port:   port,
+		pivot:  pivot,
+		logger: logger,
+	}, nil
+}
+
+// storedPair returns the pair the rate of the two currencies is stored under
+func storedPair(from string, to string) entities.CurrencyPair {
+	if from > to {
+		return entities.CurrencyPair{From: to, To: from}
+	}
+	return entities.CurrencyPair{From: from, To: to}
+}
+
+// resolvedRate is the rate of a pair derived from the legs of its path
+type resolvedRate struct {
+	pair      entities.CurrencyPair
+	rate      *big.Rat
+	validFrom time.Time
+	validTo   time.Time
+	sequence  int64
+	path      []entities.RateLeg
+}
+
+// newerThan reports whether the rate has to be sent to a client which received the other rate of the pair
+func (r *resolvedRate) newerThan(other *resolvedRate) bool {
+	return other == nil || r.sequence > other.sequence || !samePath(r.path, other.path)
+}
+
+func (r *resolvedRate) toExchangeRate() entities.ExchangeRate {
+	return entities.ExchangeRate{
+		Pair:      r.pair,
+		Rate:      formatRate(r.rate),
+		ValidFrom: r.validFrom,
+		ValidTo:   r.validTo,
+		Sequence:  r.sequence,
+		Path:      r.path,
+	}
+}
+
+func samePath(a []entities.RateLeg, b []entities.RateLeg) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Pair != b[i].Pair || a[i].Inverted != b[i].Inverted {
+			return false
+		}
+	}
+	return true
+}
+
+// rateLookup returns the rate stored under the pair
+type rateLookup func(ctx context.Context, pair entities.CurrencyPair) (*adapters.ExchangeRateModel, error)
+
+// current returns the rate of the pair valid right now, or a stale exchange rate error when a leg of its path has expired
+func (r *RateResolver) current(ctx context.Context, pair entities.CurrencyPair) (*resolvedRate, error) {
+	return r.resolve(ctx, pair, func(ctx context.Context, stored entities.CurrencyPair) (*adapters.ExchangeRateModel, error) {
+		return r.port.GetByCurrencies(ctx, stored.From, stored.To)
+	})
+}
+
+// latest returns the latest rate of the pair whether or not it's still valid
+func (r *RateResolver) latest(ctx context.Context, pair entities.CurrencyPair) (*resolvedRate, error) {
+	return r.resolve(ctx, pair, func(ctx context.Context, stored entities.CurrencyPair) (*adapters.ExchangeRateModel, error) {
+		return r.port.GetLatest(ctx, stored.From, stored.To)
+	})
+}
+
+// asOf returns the rate the pair had at the given time
+func (r *RateResolver) asOf(ctx context.Context, pair entities.CurrencyPair, at time.Time) (*resolvedRate, error) {
+	return r.resolve(ctx, pair, func(ctx context.Context, stored entities.CurrencyPair) (*adapters.ExchangeRateModel, error) {
+		return r.port.GetAsOf(ctx, stored.From, stored.To, at)
+	})
+}
+
+// candidates returns the stored pairs the rate of the pair may be derived from, whether or not they have a rate yet
+func (r *RateResolver) candidates(pair entities.CurrencyPair) []entities.CurrencyPair {
+	if pair.From == pair.To {
+		return nil
+	}
+	pairs := []entities.CurrencyPair{storedPair(pair.From, pair.To)}
+	if r.crossable(pair) {
+		pairs = append(pairs, storedPair(pair.From, r.pivot), storedPair(r.pivot, pair.To))
+	}
+	return pairs
+}
+
+// loadLatest reads the latest stored rates of the pairs missing from the cache. The pairs without a rate are left out.
+func (r *RateResolver) loadLatest(ctx context.Context, pairs []entities.CurrencyPair, cache map[entities.CurrencyPair]*adapters.ExchangeRateModel) error {
+	for _, pair := range pairs {
+		if _, exists := cache[pair]; exists {
+			continue
+		}
+		exRate, err := r.port.GetLatest(ctx, pair.From, pair.To)
+		if err != nil {
+			if domainErrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		cache[pair] = exRate
+	}
+	return nil
+}
+
+// cached returns the latest rate of the pair derived from the stored rates of the cache
+func (r *RateResolver) cached(ctx context.Context, pair entities.CurrencyPair, cache map[entities.CurrencyPair]*adapters.ExchangeRateModel) (*resolvedRate, error) {
+	return r.resolve(ctx, pair, func(_ context.Context, stored entities.CurrencyPair) (*adapters.ExchangeRateModel, error) {
+		if exRate, exists := cache[stored]; exists {
+			return exRate, nil
+		}
+		return nil, domainErrors.NotFoundError("exchange rate", stored.From+"/"+stored.To)
+	})
+}
+
+// cacheRate keeps the stored rate unless the cache already holds a later sequence of the pair
+func cacheRate(cache map[entities.CurrencyPair]*adapters.ExchangeRateModel, exRate *adapters.ExchangeRateModel) {
+	pair := entities.CurrencyPair{From: exRate.FromCurrency, To: exRate.ToCurrency}
+	if cached, exists := cache[pair]; exists && cached.Sequence >= exRate.Sequence {
+		return
+	}
+	cache[pair] = exRate
+}
+
+// crossable reports whether the pair can be crossed through the pivot currency
+func (r *RateResolver) crossable(pair entities.CurrencyPair) bool {
+	return r.pivot != "" && pair.From != r.pivot && pair.To != r.pivot
+}
+
+// resolve uses the stored rate of the pair, direct or inverted, and falls back to the cross rate through the pivot currency
+func (r *RateResolver) resolve(ctx context.Context, pair entities.CurrencyPair, lookup rateLookup) (*resolvedRate, error) {
+	if pair.From == pair.To {
+		return &resolvedRate{pair: pair, rate: big.NewRat(1, 1)}, nil
+	}
+
+	direct, err := r.leg(ctx, pair.From, pair.To, lookup)
+	if err == nil {
+		return combineLegs(pair, direct)
+	}
+	if !domainErrors.IsNotFound(err) {
+		return nil, err
+	}
+	if !r.crossable(pair) {
+		return nil, domainErrors.NotFoundError("exchange rate", pair.From+"/"+pair.To)
+	}
+
+	first, err := r.leg(ctx, pair.From, r.pivot, lookup)
+	if err != nil {
+		if domainErrors.IsNotFound(err) {
+			return nil, domainErrors.NotFoundError("exchange rate", pair.From+"/"+pair.To)
+		}
+		return nil, err
+	}
+	second, err := r.leg(ctx, r.pivot, pair.To, lookup)
+	if err != nil {
+		if domainErrors.IsNotFound(err) {
+			return nil, domainErrors.NotFoundError("exchange rate", pair.From+"/"+pair.To)
+		}
+		return nil, err
+	}
+	r.logger.Debug().Ctx(ctx).
+		Str("from_currency", pair.From).
+		Str("to_currency", pair.To).
+		Str("pivot_currency", r.pivot).
+		Msg("crossing exchange rate through the pivot currency")
+	return combineLegs(pair, first, second)
+}
+
+// rateLeg is a stored rate turned in the direction of the conversion
+type rateLeg struct {
+	exRate   *adapters.ExchangeRateModel
+	inverted bool
+}
+
+func (r *RateResolver) leg(ctx context.Context, from string, to string, lookup rateLookup) (rateLeg, error) {
+	exRate, err := lookup(ctx, storedPair(from, to))
+	if err != nil {
+		return rateLeg{}, err
+	}
+	return rateLeg{exRate: exRate, inverted: from > to}, nil
+}
+
+// combineLegs multiplies the rates of the legs. The combined rate is valid while all the legs are valid
+// and its sequence, the sum of the leg sequences, grows whenever one of them changes.
+func combineLegs(pair entities.CurrencyPair, legs ...rateLeg) (*resolvedRate, error) {
+	resolved := &resolvedRate{pair: pair, rate: big.NewRat(1, 1)}
+	for i, leg := range legs {
+		rate, err := entities.ParseRate(leg.exRate.Rate)
+		if err != nil {
+			return nil, err
+		}
+		if leg.inverted {
+			rate.Inv(rate)
+		}
+		resolved.rate.Mul(resolved.rate, rate)
+
+		if i == 0 || leg.exRate.ValidFromTimestamp.After(resolved.validFrom) {
+			resolved.validFrom = leg.exRate.ValidFromTimestamp
+		}
+		if i == 0 || leg.exRate.ValidToTimestamp.Before(resolved.validTo) {
+			resolved.validTo = leg.exRate.ValidToTimestamp
+		}
+		resolved.sequence += leg.exRate.Sequence
+		resolved.path = append(resolved.path, entities.RateLeg{
+			Pair:     entities.CurrencyPair{From: leg.exRate.FromCurrency, To: leg.exRate.ToCurrency},
+			Rate:     leg.exRate.Rate,
+			Inverted: leg.inverted,
+			Sequence: leg.exRate.Sequence,
+		})
+	}
+	return resolved, nil
+}
@@ -0,0 +1,168 @@
+package domains
+
+import (
+	"context"
+	"time"
+
+	entities "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	domainErrors "github.com/cybrarymin/gRPC/server/internals/domains/errors"
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/ports"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type RateSchedulerConfig struct {
+	PollInterval time.Duration // time between two fetches of every provider
+	Validity     time.Duration // validity window of the written rates, should be a few poll intervals
+}
+
+// RateScheduler polls the rate providers and writes their rates. Every write is recorded in the rate history
+// and pushed to the rate streams by the database. When several providers publish the same pair, in either direction, the last provider wins.
+type RateScheduler struct {
+	port      domains.BankExchangeRateRepositoryPort
+	providers []domains.RateProviderPort
+	cfg       RateSchedulerConfig
+	logger    *zerolog.Logger
+}
+
+func NewRateScheduler(port domains.BankExchangeRateRepositoryPort, providers []domains.RateProviderPort, cfg RateSchedulerConfig, logger *zerolog.Logger) *RateScheduler {
+	return &RateScheduler{
+		port:      port,
+		providers: providers,
+		cfg:       cfg,
+		logger:    logger,
+	}
+}
+
+// Run fetches the rates of every provider right away and then every poll interval until the context is done.
+// The providers watching their source are fetched again as soon as it changes.
+func (s *RateScheduler) Run(ctx context.Context) {
+	s.logger.Info().
+		Int("providers", len(s.providers)).
+		Dur("poll_interval", s.cfg.PollInterval).
+		Msg("started exchange rate scheduler")
+
+	refreshes := make(chan domains.RateProviderPort)
+	for _, provider := range s.providers {
+		watcher, ok := provider.(domains.RateProviderWatcherPort)
+		if !ok {
+			continue
+		}
+		changes, err := watcher.Watch(ctx)
+		if err != nil {
+			s.logger.Warn().Err(err).Str("provider", provider.Name()).Msg("failed to watch rate provider, falling back to polling")
+			continue
+		}
+		go func(provider domains.RateProviderPort) {
+			for range changes {
+				select {
+				case refreshes <- provider:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(provider)
+	}
+
+	s.refreshAll(ctx)
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.refreshAll(ctx)
+		case provider := <-refreshes:
+			s.refresh(ctx, provider)
+		}
+	}
+}
+
+func (s *RateScheduler) refreshAll(ctx context.Context) {
+	for _, provider := range s.providers {
+		s.refresh(ctx, provider)
+	}
+}
+
+// refresh writes the rates of the provider which changed or are about to expire
+func (s *RateScheduler) refresh(pCtx context.Context, provider domains.RateProviderPort) {
+	ctx, nSpan := otel.Tracer("RefreshRates").Start(pCtx, "RefreshRates.service.span")
+	defer nSpan.End()
+
+	rates, err := provider.FetchRates(ctx)
+	if err != nil {
+		s.logger.Error().Ctx(ctx).Err(err).Str("provider", provider.Name()).Msg("failed to fetch exchange rates")
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to fetch exchange rates")
+		recordProviderFetch(ctx, provider.Name(), providerFetchFailed)
+		return
+	}
+	recordProviderFetch(ctx, provider.Name(), providerFetchSucceeded)
+
+	var written, skipped int
+	for _, provided := range rates {
+		ok, err := s.save(ctx, provided)
+		if err != nil {
+			s.logger.Warn().Ctx(ctx).Err(err).
+				Str("provider", provider.Name()).
+				Str("from_currency", provided.Pair.From).
+				Str("to_currency", provided.Pair.To).
+				Str("rate", provided.Rate).
+				Msg("skipped exchange rate")
+			skipped++
+			continue
+		}
+		if ok {
+			written++
+		}
+	}
+	s.logger.Info().Ctx(ctx).
+		Str("provider", provider.Name()).
+		Int("fetched", len(rates)).
+		Int("written", written).
+		Int("skipped", skipped).
+		Msg("refreshed exchange rates")
+}
+
+// save writes the rate unless the pair already has the same rate valid beyond the next poll
+func (s *RateScheduler) save(ctx context.Context, provided entities.ProvidedRate) (bool, error) {
+	// the bank only converts between the currencies it supports
+	if _, err := entities.CurrencyExponent(provided.Pair.From); err != nil {
+		return false, err
+	}
+	if _, err := entities.CurrencyExponent(provided.Pair.To); err != nil {
+		return false, err
+	}
+	if provided.Pair.From == provided.Pair.To {
+		return false, domainErrors.InvalidInputError("a currency can't be converted to itself")
+	}
+	rate, err := entities.ParseRate(provided.Rate)
+	if err != nil {
+		return false, err
+	}
+	// the rate of two currencies is stored in a single direction, a rate provided the other way round is stored inverted
+	pair := storedPair(provided.Pair.From, provided.Pair.To)
+	if pair != provided.Pair {
+		rate.Inv(rate)
+	}
+	stored := formatRate(rate)
+
+	now := time.Now()
+	current, err := s.port.GetLatest(ctx, pair.From, pair.To)
+	if err != nil && !domainErrors.IsNotFound(err) {
+		return false, err
+	}
+	if current != nil && current.ValidToTimestamp.Sub(now) > 2*s.cfg.PollInterval {
+		if currentRate, err := entities.ParseRate(current.Rate); err == nil && formatRate(currentRate) == stored {
+			return false, nil
+		}
+	}
+
+	_, err = s.port.SaveRate(ctx, pair.From, pair.To, stored, now, now.Add(s.cfg.Validity))
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
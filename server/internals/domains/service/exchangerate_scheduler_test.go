@@ -0,0 +1,165 @@
+package domains
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	adapters "github.com/cybrarymin/gRPC/server/internals/adapters/driven_adapters/database"
+	entities "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	domainErrors "github.com/cybrarymin/gRPC/server/internals/domains/errors"
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/ports"
+	"github.com/rs/zerolog"
+)
+
+// fakeRateStore keeps the latest rate of each stored pair in memory, the other port methods aren't used by the scheduler
+type fakeRateStore struct {
+	domains.BankExchangeRateRepositoryPort
+	rates map[entities.CurrencyPair]*adapters.ExchangeRateModel
+	saves int
+}
+
+func (f *fakeRateStore) GetLatest(_ context.Context, from string, to string) (*adapters.ExchangeRateModel, error) {
+	exRate, exists := f.rates[entities.CurrencyPair{From: from, To: to}]
+	if !exists {
+		return nil, domainErrors.NotFoundError("exchange rate", from+"/"+to)
+	}
+	return exRate, nil
+}
+
+func (f *fakeRateStore) SaveRate(_ context.Context, from string, to string, rate string, validFrom time.Time, validTo time.Time) (*adapters.ExchangeRateModel, error) {
+	f.saves++
+	exRate := &adapters.ExchangeRateModel{
+		FromCurrency:       from,
+		ToCurrency:         to,
+		Rate:               rate,
+		ValidFromTimestamp: validFrom,
+		ValidToTimestamp:   validTo,
+		Sequence:           int64(f.saves),
+	}
+	f.rates[entities.CurrencyPair{From: from, To: to}] = exRate
+	return exRate, nil
+}
+
+func newTestScheduler(store *fakeRateStore) *RateScheduler {
+	logger := zerolog.Nop()
+	return NewRateScheduler(store, nil, RateSchedulerConfig{
+		PollInterval: time.Minute,
+		Validity:     10 * time.Minute,
+	}, &logger)
+}
+
+func TestRateSchedulerSave(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		stored    *adapters.ExchangeRateModel
+		provided  entities.ProvidedRate
+		wantSaved bool
+		wantPair  entities.CurrencyPair
+		wantRate  string
+		wantErr   bool
+	}{
+		{
+			name:      "stored direction",
+			provided:  entities.ProvidedRate{Pair: entities.CurrencyPair{From: "EUR", To: "USD"}, Rate: "1.25"},
+			wantSaved: true,
+			wantPair:  entities.CurrencyPair{From: "EUR", To: "USD"},
+			wantRate:  "1.2500000000",
+		},
+		{
+			name:      "reverse direction is inverted",
+			provided:  entities.ProvidedRate{Pair: entities.CurrencyPair{From: "USD", To: "EUR"}, Rate: "0.8"},
+			wantSaved: true,
+			wantPair:  entities.CurrencyPair{From: "EUR", To: "USD"},
+			wantRate:  "1.2500000000",
+		},
+		{
+			name: "unchanged rate still valid is skipped",
+			stored: &adapters.ExchangeRateModel{
+				FromCurrency: "EUR", ToCurrency: "USD", Rate: "1.2500000000",
+				ValidFromTimestamp: now, ValidToTimestamp: now.Add(10 * time.Minute),
+			},
+			provided:  entities.ProvidedRate{Pair: entities.CurrencyPair{From: "USD", To: "EUR"}, Rate: "0.80"},
+			wantSaved: false,
+			wantPair:  entities.CurrencyPair{From: "EUR", To: "USD"},
+			wantRate:  "1.2500000000",
+		},
+		{
+			name: "unchanged rate about to expire is written again",
+			stored: &adapters.ExchangeRateModel{
+				FromCurrency: "EUR", ToCurrency: "USD", Rate: "1.2500000000",
+				ValidFromTimestamp: now.Add(-9 * time.Minute), ValidToTimestamp: now.Add(time.Minute),
+			},
+			provided:  entities.ProvidedRate{Pair: entities.CurrencyPair{From: "EUR", To: "USD"}, Rate: "1.25"},
+			wantSaved: true,
+			wantPair:  entities.CurrencyPair{From: "EUR", To: "USD"},
+			wantRate:  "1.2500000000",
+		},
+		{
+			name: "changed rate is written",
+			stored: &adapters.ExchangeRateModel{
+				FromCurrency: "EUR", ToCurrency: "USD", Rate: "1.2500000000",
+				ValidFromTimestamp: now, ValidToTimestamp: now.Add(10 * time.Minute),
+			},
+			provided:  entities.ProvidedRate{Pair: entities.CurrencyPair{From: "EUR", To: "USD"}, Rate: "1.26"},
+			wantSaved: true,
+			wantPair:  entities.CurrencyPair{From: "EUR", To: "USD"},
+			wantRate:  "1.2600000000",
+		},
+		{
+			name:     "unsupported currency",
+			provided: entities.ProvidedRate{Pair: entities.CurrencyPair{From: "EUR", To: "XYZ"}, Rate: "1.25"},
+			wantErr:  true,
+		},
+		{
+			name:     "same currency",
+			provided: entities.ProvidedRate{Pair: entities.CurrencyPair{From: "EUR", To: "EUR"}, Rate: "1"},
+			wantErr:  true,
+		},
+		{
+			name:     "non positive rate",
+			provided: entities.ProvidedRate{Pair: entities.CurrencyPair{From: "EUR", To: "USD"}, Rate: "0"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &fakeRateStore{rates: make(map[entities.CurrencyPair]*adapters.ExchangeRateModel)}
+			if tt.stored != nil {
+				store.rates[entities.CurrencyPair{From: tt.stored.FromCurrency, To: tt.stored.ToCurrency}] = tt.stored
+			}
+
+			saved, err := newTestScheduler(store).save(context.Background(), tt.provided)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				if store.saves != 0 {
+					t.Fatalf("invalid rate was written %d times", store.saves)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if saved != tt.wantSaved {
+				t.Fatalf("saved = %v, want %v", saved, tt.wantSaved)
+			}
+			if tt.wantSaved != (store.saves == 1) {
+				t.Fatalf("rate was written %d times", store.saves)
+			}
+			if len(store.rates) != 1 {
+				t.Fatalf("got %d stored pairs, want a single direction", len(store.rates))
+			}
+			exRate, exists := store.rates[tt.wantPair]
+			if !exists {
+				t.Fatalf("no rate stored under %s/%s", tt.wantPair.From, tt.wantPair.To)
+			}
+			if exRate.Rate != tt.wantRate {
+				t.Errorf("stored rate = %s, want %s", exRate.Rate, tt.wantRate)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package domains
+
+import (
+	"context"
+
+	ports "github.com/cybrarymin/gRPC/server/internals/domains/ports"
+	"github.com/rs/zerolog"
+)
+
+type HealthService struct {
+	port   ports.HealthRepositoryPort
+	logger *zerolog.Logger
+}
+
+func NewHealthService(port ports.HealthRepositoryPort, logger *zerolog.Logger) *HealthService {
+	logger.Debug().Msg("Initializing HealthService")
+	return &HealthService{
+		port,
+		logger,
+	}
+}
+
+// CheckReadiness reports whether the dependencies needed to serve requests are reachable.
+// It runs every few seconds so it doesn't open a span of its own.
+func (s *HealthService) CheckReadiness(ctx context.Context) error {
+	return s.port.Ping(ctx)
+}
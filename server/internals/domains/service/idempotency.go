@@ -0,0 +1,61 @@
+package domains
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	domainErrors "github.com/cybrarymin/gRPC/server/internals/domains/errors"
+	ports "github.com/cybrarymin/gRPC/server/internals/domains/ports"
+	"github.com/google/uuid"
+)
+
+// requestHash fingerprints the business fields of a request so a replayed idempotency key can be checked against its original payload
+func requestHash(fields ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(fields, "\x1f")))
+	return hex.EncodeToString(sum[:])
+}
+
+// reserveIdempotencyKey must be called with a transactional context.
+// It returns the uuid of the resource created by the original request when the key is replayed with the same payload,
+// uuid.Nil when the key is new, and an error when the key was used for a different payload.
+func reserveIdempotencyKey(txCtx context.Context, port ports.IdempotencyKeyRepositoryPort, operation string, key string, hash string) (uuid.UUID, error) {
+	now := time.Now()
+	record, created, err := port.ReserveIdempotencyKey(txCtx, &domains.IdempotencyKey{
+		Principal:   idempotencyPrincipal(txCtx),
+		Operation:   operation,
+		Key:         key,
+		RequestHash: hash,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if created {
+		return uuid.Nil, nil
+	}
+	if record.RequestHash != hash || record.ResourceUUID == uuid.Nil {
+		return uuid.Nil, domainErrors.IdempotencyKeyReusedError(operation, key)
+	}
+	return record.ResourceUUID, nil
+}
+
+// setIdempotencyKeyResource links the idempotency key reserved by reserveIdempotencyKey to the resource created by the request
+func setIdempotencyKeyResource(txCtx context.Context, port ports.IdempotencyKeyRepositoryPort, operation string, key string, resourceUUID uuid.UUID) error {
+	return port.SetIdempotencyKeyResource(txCtx, idempotencyPrincipal(txCtx), operation, key, resourceUUID)
+}
+
+// idempotencyPrincipal returns the principal the idempotency keys of the request belong to.
+// A key replayed by another principal is a new key, so a caller never gets back a resource created by someone else.
+// The requests served without authentication share the empty principal.
+func idempotencyPrincipal(ctx context.Context) string {
+	principal := domains.PrincipalFromContext(ctx)
+	if principal == nil {
+		return ""
+	}
+	return principal.AuthMethod + ":" + principal.Subject
+}
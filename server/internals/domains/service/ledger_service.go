@@ -0,0 +1,101 @@
+package domains
+
+import (
+	"context"
+	"time"
+
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	ports "github.com/cybrarymin/gRPC/server/internals/domains/ports"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type LedgerService struct {
+	port   ports.LedgerRepositoryPort
+	logger *zerolog.Logger
+}
+
+func NewLedgerService(port ports.LedgerRepositoryPort, logger *zerolog.Logger) *LedgerService {
+	logger.Debug().Msg("Initializing LedgerService")
+	return &LedgerService{
+		port,
+		logger,
+	}
+}
+
+// postJournalEntry validates the entry before storing it. It must be called with the transactional context
+// that changes the balances, so the postings and the balances are committed or rolled back together.
+func postJournalEntry(txCtx context.Context, port ports.LedgerRepositoryPort, entry *domains.JournalEntry) error {
+	if err := entry.Validate(); err != nil {
+		return err
+	}
+	return port.CreateJournalEntry(txCtx, entry)
+}
+
+// VerifyLedger compares the stored balance of every bank account with the sum of its postings
+// and looks for journal entries whose postings don't sum up to zero
+func (s *LedgerService) VerifyLedger(ctx context.Context) (*domains.LedgerReport, error) {
+	sCtx, nSpan := otel.Tracer("VerifyLedger").Start(ctx, "VerifyLedger.service.span")
+	defer nSpan.End()
+
+	startTime := time.Now()
+	report := &domains.LedgerReport{
+		Mismatches:        make([]domains.LedgerBalanceMismatch, 0),
+		UnbalancedEntries: make([]domains.UnbalancedJournalEntry, 0),
+		CheckedAt:         startTime,
+	}
+
+	accountsChecked, err := s.port.CountAccounts(sCtx)
+	if err != nil {
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to count bank accounts")
+		return nil, err
+	}
+	report.AccountsChecked = accountsChecked
+
+	mismatchModels, err := s.port.FindBalanceMismatches(sCtx)
+	if err != nil {
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to compare balances with the ledger")
+		return nil, err
+	}
+	for i := range mismatchModels {
+		mismatch, err := mismatchModels[i].ToLedgerBalanceMismatch()
+		if err != nil {
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "failed to convert balance mismatch")
+			return nil, err
+		}
+		report.Mismatches = append(report.Mismatches, *mismatch)
+	}
+
+	entryModels, err := s.port.FindUnbalancedEntries(sCtx)
+	if err != nil {
+		nSpan.RecordError(err)
+		nSpan.SetStatus(codes.Error, "failed to look for unbalanced journal entries")
+		return nil, err
+	}
+	for i := range entryModels {
+		entry, err := entryModels[i].ToUnbalancedJournalEntry()
+		if err != nil {
+			nSpan.RecordError(err)
+			nSpan.SetStatus(codes.Error, "failed to convert unbalanced journal entry")
+			return nil, err
+		}
+		report.UnbalancedEntries = append(report.UnbalancedEntries, *entry)
+	}
+
+	logEvent := s.logger.Info().Ctx(sCtx)
+	if !report.Balanced() {
+		logEvent = s.logger.Warn().Ctx(sCtx)
+	}
+	logEvent.
+		Int("accounts_checked", report.AccountsChecked).
+		Int("balance_mismatches", len(report.Mismatches)).
+		Int("unbalanced_entries", len(report.UnbalancedEntries)).
+		Dur("duration_ms", time.Since(startTime)).
+		Msg("ledger verification finished")
+
+	return report, nil
+}
@@ -0,0 +1,63 @@
+package domains
+
+import (
+	"context"
+	"strconv"
+
+	domains "github.com/cybrarymin/gRPC/server/internals/domains/entities"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	transferOutcomeCompleted = "completed"
+	transferOutcomeFailed    = "failed"
+
+	providerFetchSucceeded = "succeeded"
+	providerFetchFailed    = "failed"
+)
+
+// Business metrics of the domain services. The instruments are created on the global meter provider,
+// which forwards them to the provider set up at startup.
+var (
+	meter = otel.Meter("github.com/cybrarymin/gRPC/server/internals/domains/service")
+
+	transfersCounter, _ = meter.Int64Counter("bank.transfers",
+		metric.WithDescription("Number of money transfers by outcome"),
+		metric.WithUnit("{transfer}"))
+	transferVolumeCounter, _ = meter.Float64Counter("bank.transfers.volume",
+		metric.WithDescription("Amount moved by the completed transfers, in major units of the transfer currency"))
+	skippedRatesCounter, _ = meter.Int64Counter("bank.exchange_rate.updates.skipped",
+		metric.WithDescription("Exchange rate changes never sent to a slow subscriber because a newer rate replaced them"),
+		metric.WithUnit("{update}"))
+	providerFetchesCounter, _ = meter.Int64Counter("bank.exchange_rate.provider.fetches",
+		metric.WithDescription("Number of exchange rate fetches by provider and outcome"),
+		metric.WithUnit("{fetch}"))
+)
+
+// recordTransfer counts a finished transfer and adds the amount of the completed ones to the volume of its currency
+func recordTransfer(ctx context.Context, outcome string, amount domains.Money) {
+	currency := attribute.String("currency", amount.Currency)
+	transfersCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("outcome", outcome), currency))
+	if outcome != transferOutcomeCompleted {
+		return
+	}
+	if volume, err := strconv.ParseFloat(amount.Decimal(), 64); err == nil {
+		transferVolumeCounter.Add(ctx, volume, metric.WithAttributes(currency))
+	}
+}
+
+// recordSkippedRate counts a rate change replaced by a newer one before a slow subscriber read it
+func recordSkippedRate(ctx context.Context, fromCurrency string, toCurrency string) {
+	skippedRatesCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("from_currency", fromCurrency),
+		attribute.String("to_currency", toCurrency)))
+}
+
+// recordProviderFetch counts a fetch of the rates of a provider
+func recordProviderFetch(ctx context.Context, provider string, outcome string) {
+	providerFetchesCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("provider", provider),
+		attribute.String("outcome", outcome)))
+}